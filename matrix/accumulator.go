@@ -0,0 +1,47 @@
+package matrix
+
+// MatrixMeanAccumulator maintains a running element-wise mean of equally-shaped matrices.
+// Only the current mean is stored, so arbitrarily long streams can be averaged in constant memory.
+// The zero value is ready to use; the first matrix added fixes the expected shape.
+type MatrixMeanAccumulator struct {
+    count int
+    mean  Matrix
+}
+
+// Add includes m in the running mean.
+// Returns an error if m does not have the same shape as the matrices added before it.
+func (a *MatrixMeanAccumulator) Add(m Matrix) error {
+    if a.count == 0 {
+        a.mean = m.clone()
+        a.count = 1
+        return nil
+    }
+    if m.Rows != a.mean.Rows || m.Cols != a.mean.Cols {
+        return elementwiseMismatch("MatrixMeanAccumulator.Add", a.mean, m)
+    }
+
+    // Incremental update keeps the mean well scaled instead of summing everything first
+    a.count++
+    n := float64(a.count)
+    for i := range m.Data {
+        for j := range m.Data[i] {
+            a.mean.Data[i][j] += (m.Data[i][j] - a.mean.Data[i][j]) / n
+        }
+    }
+
+    return nil
+}
+
+// Count returns the number of matrices added so far.
+func (a *MatrixMeanAccumulator) Count() int {
+    return a.count
+}
+
+// Mean returns a copy of the element-wise mean of the matrices added so far.
+// Returns an empty Matrix if nothing has been added.
+func (a *MatrixMeanAccumulator) Mean() Matrix {
+    if a.count == 0 {
+        return Matrix{}
+    }
+    return a.mean.clone()
+}
@@ -0,0 +1,49 @@
+package matrix
+
+import (
+    "errors"
+    "testing"
+)
+
+// TestMatrixMeanAccumulator tests the running mean against a batch average.
+func TestMatrixMeanAccumulator(t *testing.T) {
+    inputs := []Matrix{
+        {Rows: 2, Cols: 2, Data: [][]float64{{1, 2}, {3, 4}}},
+        {Rows: 2, Cols: 2, Data: [][]float64{{5, -6}, {7, 0}}},
+        {Rows: 2, Cols: 2, Data: [][]float64{{0, 1}, {-1, 8}}},
+    }
+
+    var acc MatrixMeanAccumulator
+    for _, m := range inputs {
+        if err := acc.Add(m); err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+    }
+
+    total, err := inputs[0].Add(inputs[1])
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    total, err = total.Add(inputs[2])
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    batch, err := total.Map(func(x float64) float64 { return x / 3 })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if acc.Count() != 3 {
+        t.Fatalf("expected count 3, got %d", acc.Count())
+    }
+    assertClose(t, batch.Data, acc.Mean())
+
+    if inputs[0].Data[0][0] != 1 {
+        t.Fatalf("expected inputs to be unchanged, got %v", inputs[0].Data)
+    }
+
+    err = acc.Add(Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
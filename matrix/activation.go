@@ -0,0 +1,92 @@
+package matrix
+
+import (
+    "math"
+)
+
+// mapOrPanic applies an infallible function to every element.
+// Map only fails when the matrix dimensions are invalid, which would be a programming error here.
+func (m Matrix) mapOrPanic(f func(float64) float64) Matrix {
+    result, err := m.Map(f)
+
+    if err != nil {
+        panic(err)
+    }
+
+    return result
+}
+
+// relu returns x if it is positive and 0 otherwise.
+func relu(x float64) float64 {
+    if x > 0 {
+        return x
+    }
+    return 0
+}
+
+// sigmoid returns the logistic function 1 / (1 + e^-x).
+func sigmoid(x float64) float64 {
+    return 1 / (1 + math.Exp(-x))
+}
+
+// ReLU applies the rectified linear unit max(0, x) to every element.
+func (m Matrix) ReLU() Matrix {
+    return m.mapOrPanic(relu)
+}
+
+// ReLUDeriv returns the element-wise derivative of ReLU evaluated at each element.
+// The derivative at exactly zero is taken to be 0.
+func (m Matrix) ReLUDeriv() Matrix {
+    return m.mapOrPanic(func(x float64) float64 {
+        if x > 0 {
+            return 1
+        }
+        return 0
+    })
+}
+
+// Sigmoid applies the logistic function 1/(1+e^-x) to every element.
+func (m Matrix) Sigmoid() Matrix {
+    return m.mapOrPanic(sigmoid)
+}
+
+// SigmoidDeriv returns the element-wise derivative of Sigmoid evaluated at each element.
+// The derivative is sigmoid(x) * (1 - sigmoid(x)), computed from the input rather than the activation.
+func (m Matrix) SigmoidDeriv() Matrix {
+    return m.mapOrPanic(func(x float64) float64 {
+        s := sigmoid(x)
+        return s * (1 - s)
+    })
+}
+
+// Tanh applies the hyperbolic tangent to every element.
+func (m Matrix) Tanh() Matrix {
+    return m.mapOrPanic(math.Tanh)
+}
+
+// TanhDeriv returns the element-wise derivative of Tanh evaluated at each element.
+// The derivative is 1 - tanh(x)^2, computed from the input rather than the activation.
+func (m Matrix) TanhDeriv() Matrix {
+    return m.mapOrPanic(func(x float64) float64 {
+        t := math.Tanh(x)
+        return 1 - t*t
+    })
+}
+
+// SoftmaxRows applies softmax to each row, so every row of the result is positive and sums to 1.
+// The row maximum is subtracted before exponentiating, which avoids overflow for large inputs.
+func (m Matrix) SoftmaxRows() Matrix {
+    result := m.clone()
+    for _, row := range result.Data {
+        largest := maximum(row)
+        total := 0.0
+        for j := range row {
+            row[j] = math.Exp(row[j] - largest)
+            total += row[j]
+        }
+        for j := range row {
+            row[j] /= total
+        }
+    }
+    return result
+}
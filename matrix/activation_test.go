@@ -0,0 +1,60 @@
+package matrix
+
+import (
+    "math"
+    "testing"
+)
+
+// assertClose fails the test if any element of got differs from expected by more than 1e-9.
+func assertClose(t *testing.T, expected [][]float64, got Matrix) {
+    t.Helper()
+    if len(expected) != got.Rows || len(expected[0]) != got.Cols {
+        t.Fatalf("expected %v, got %v", expected, got.Data)
+    }
+    for i := range expected {
+        for j := range expected[i] {
+            if math.Abs(got.Data[i][j]-expected[i][j]) > 1e-9 {
+                t.Fatalf("expected %v, got %v", expected, got.Data)
+            }
+        }
+    }
+}
+
+var activationInput = Matrix{
+    Rows: 1,
+    Cols: 3,
+    Data: [][]float64{
+        {-2, 0, 1},
+    },
+}
+
+func TestReLU(t *testing.T) {
+    assertClose(t, [][]float64{{0, 0, 1}}, activationInput.ReLU())
+    assertClose(t, [][]float64{{0, 0, 1}}, activationInput.ReLUDeriv())
+}
+
+func TestSigmoid(t *testing.T) {
+    // sigmoid(-2) = 0.11920292202, sigmoid(1) = 0.73105857863
+    assertClose(t, [][]float64{{0.11920292202211755, 0.5, 0.7310585786300049}}, activationInput.Sigmoid())
+    assertClose(t, [][]float64{{0.10499358540350652, 0.25, 0.19661193324148185}}, activationInput.SigmoidDeriv())
+}
+
+func TestTanh(t *testing.T) {
+    // tanh(-2) = -0.96402758007, tanh(1) = 0.76159415595
+    assertClose(t, [][]float64{{-0.9640275800758169, 0, 0.7615941559557649}}, activationInput.Tanh())
+    assertClose(t, [][]float64{{0.07065082485316443, 1, 0.41997434161402614}}, activationInput.TanhDeriv())
+}
+
+func TestSoftmaxRows(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {0, 0, 0},
+            {1000, 1000 + math.Log(3), 1000},
+        },
+    }
+
+    // Large entries must not overflow
+    assertClose(t, [][]float64{{1.0 / 3, 1.0 / 3, 1.0 / 3}, {0.2, 0.6, 0.2}}, a.SoftmaxRows())
+}
@@ -0,0 +1,31 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+)
+
+// ScaledDotProductAttention computes softmax(QKᵀ/√d)V, the attention operation used in transformers.
+// Each row of q is a query, and the rows of k and v are the matching keys and values. d is the number
+// of columns shared by q and k; the result has one row per query and one column per column of v.
+// Returns an error if q and k have different numbers of columns or k and v have different numbers of rows.
+func ScaledDotProductAttention(q, k, v Matrix) (Matrix, error) {
+    if q.Cols != k.Cols {
+        return Matrix{}, fmt.Errorf("%w: queries %s and keys %s must have the same number of columns",
+            ErrDimensionMismatch, shape(q), shape(k))
+    }
+    if k.Rows != v.Rows {
+        return Matrix{}, fmt.Errorf("%w: keys %s and values %s must have the same number of rows",
+            ErrDimensionMismatch, shape(k), shape(v))
+    }
+
+    scores, err := q.Multiply(k.T())
+    if err != nil {
+        return Matrix{}, err
+    }
+
+    scale := 1 / math.Sqrt(float64(q.Cols))
+    scores.ApplyInPlace(func(x float64) float64 { return x * scale })
+
+    return scores.SoftmaxRows().Multiply(v)
+}
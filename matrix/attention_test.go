@@ -0,0 +1,57 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+func TestScaledDotProductAttention(t *testing.T) {
+    // With d = 4 the scale is 1/2, so the scores are [ln 3, 0] for the first query and [0, 0] for the second
+    q := Matrix{
+        Rows: 2,
+        Cols: 4,
+        Data: [][]float64{
+            {2 * math.Log(3), 0, 0, 0},
+            {0, 0, 0, 0},
+        },
+    }
+    k := Matrix{
+        Rows: 2,
+        Cols: 4,
+        Data: [][]float64{
+            {1, 0, 0, 0},
+            {0, 1, 0, 0},
+        },
+    }
+    v := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {4, 0, 1},
+            {0, 8, 1},
+        },
+    }
+
+    result, err := ScaledDotProductAttention(q, k, v)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    // Weights are [3/4, 1/4] and [1/2, 1/2]
+    expected := [][]float64{
+        {3, 2, 1},
+        {2, 4, 1},
+    }
+    assertClose(t, expected, result)
+
+    _, err = ScaledDotProductAttention(q, v, v)
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch for query and key columns, got %v", err)
+    }
+
+    _, err = ScaledDotProductAttention(q, k, v.T())
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch for key and value rows, got %v", err)
+    }
+}
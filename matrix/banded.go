@@ -0,0 +1,179 @@
+package matrix
+
+import (
+    "fmt"
+    "sort"
+)
+
+// bandwidth returns the largest distance |i - j| of a nonzero entry from the diagonal.
+func (m Matrix) bandwidth() int {
+    width := 0
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            if val == 0 {
+                continue
+            }
+            if d := i - j; d > width {
+                width = d
+            } else if -d > width {
+                width = -d
+            }
+        }
+    }
+    return width
+}
+
+// ReorderToBandwidth symmetrically permutes a symmetric matrix to reduce its bandwidth, returning
+// PAPᵀ and the permutation, where row i of the result is row perm[i] of the original.
+// The ordering is reverse Cuthill-McKee: a breadth-first search over the nonzero pattern, starting each
+// connected component from a vertex of lowest degree and visiting neighbours in order of increasing degree.
+// If the heuristic does not improve on the original ordering, the identity permutation is returned instead,
+// so the bandwidth never grows.
+// Returns an error if the matrix is not square or is not symmetric.
+func (m Matrix) ReorderToBandwidth() (Matrix, []int, error) {
+    if m.Rows != m.Cols {
+        return Matrix{}, nil, notSquare("ReorderToBandwidth", m)
+    }
+    if !m.IsSymmetric(symmetryTolerance) {
+        return Matrix{}, nil, fmt.Errorf("%w: ReorderToBandwidth requires a symmetric matrix", ErrNotSymmetric)
+    }
+
+    n := m.Rows
+    neighbours := make([][]int, n)
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            if i != j && val != 0 {
+                neighbours[i] = append(neighbours[i], j)
+            }
+        }
+    }
+    byDegree := func(nodes []int) {
+        sort.SliceStable(nodes, func(a, b int) bool { return len(neighbours[nodes[a]]) < len(neighbours[nodes[b]]) })
+    }
+    for i := range neighbours {
+        byDegree(neighbours[i])
+    }
+
+    starts := make([]int, n)
+    for i := range starts {
+        starts[i] = i
+    }
+    byDegree(starts)
+
+    perm := make([]int, 0, n)
+    visited := make([]bool, n)
+    for _, start := range starts {
+        if visited[start] {
+            continue
+        }
+        visited[start] = true
+        perm = append(perm, start)
+        for head := len(perm) - 1; head < len(perm); head++ {
+            for _, next := range neighbours[perm[head]] {
+                if !visited[next] {
+                    visited[next] = true
+                    perm = append(perm, next)
+                }
+            }
+        }
+    }
+    for i, j := 0, n-1; i < j; i, j = i+1, j-1 {
+        perm[i], perm[j] = perm[j], perm[i]
+    }
+
+    rows, err := m.PermuteRows(perm)
+    if err != nil {
+        panic(err)
+    }
+    reordered, err := rows.PermuteColumns(perm)
+    if err != nil {
+        panic(err)
+    }
+
+    if reordered.bandwidth() > m.bandwidth() {
+        for i := range perm {
+            perm[i] = i
+        }
+        return m.clone(), perm, nil
+    }
+
+    return reordered, perm, nil
+}
+
+// BandMatrix stores a square matrix whose nonzero entries lie within Lower diagonals below and Upper
+// diagonals above the main diagonal. Only the band is stored: Data[i][k] holds element (i, i-Lower+k)
+// for k in [0, Lower+Upper], and positions that fall outside the matrix are left at zero.
+type BandMatrix struct {
+    Size  int
+    Lower int
+    Upper int
+    Data  [][]float64
+}
+
+// NewBandMatrix copies the band of a square matrix into band storage.
+// Returns an error if the matrix is not square, a bandwidth is negative,
+// or a nonzero entry lies outside the band.
+func NewBandMatrix(m Matrix, lower, upper int) (BandMatrix, error) {
+    if m.Rows != m.Cols {
+        return BandMatrix{}, notSquare("NewBandMatrix", m)
+    }
+    if lower < 0 || upper < 0 {
+        return BandMatrix{}, fmt.Errorf("%w: bandwidths %d and %d must not be negative", ErrOutOfRange, lower, upper)
+    }
+
+    data, _ := newData[float64](m.Rows, lower+upper+1)
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            if j < i-lower || j > i+upper {
+                if val != 0 {
+                    return BandMatrix{}, fmt.Errorf("%w: entry (%d, %d) lies outside bandwidths %d and %d",
+                        ErrOutOfRange, i, j, lower, upper)
+                }
+                continue
+            }
+            data[i][j-i+lower] = val
+        }
+    }
+
+    return BandMatrix{Size: m.Rows, Lower: lower, Upper: upper, Data: data}, nil
+}
+
+// MultiplyVector returns the product of the band matrix and v, touching only the stored band
+// for O(Size*(Lower+Upper+1)) work.
+// Returns an error if len(v) differs from Size.
+func (b BandMatrix) MultiplyVector(v []float64) ([]float64, error) {
+    if len(v) != b.Size {
+        return nil, fmt.Errorf("%w: cannot multiply %d×%d band matrix by vector of length %d",
+            ErrDimensionMismatch, b.Size, b.Size, len(v))
+    }
+
+    result := make([]float64, b.Size)
+    for i, band := range b.Data {
+        first := maxInt(i-b.Lower, 0)
+        last := minInt(i+b.Upper, b.Size-1)
+        for j := first; j <= last; j++ {
+            result[i] += band[j-i+b.Lower] * v[j]
+        }
+    }
+
+    return result, nil
+}
+
+// ToDense returns the band matrix as an ordinary Matrix with zeros outside the band.
+func (b BandMatrix) ToDense() Matrix {
+    result, err := NewZeroMatrix(b.Size, b.Size)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i, band := range b.Data {
+        first := maxInt(i-b.Lower, 0)
+        last := minInt(i+b.Upper, b.Size-1)
+        for j := first; j <= last; j++ {
+            result.Data[i][j] = band[j-i+b.Lower]
+        }
+    }
+
+    return result
+}
@@ -0,0 +1,114 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+// TestReorderToBandwidth tests that a path graph with scrambled vertex labels is reordered to a tridiagonal matrix.
+func TestReorderToBandwidth(t *testing.T) {
+    // Laplacian of the path 0-4-1-3-5-2, which has bandwidth 4 as labelled
+    order := []int{0, 4, 1, 3, 5, 2}
+    a, err := NewZeroMatrix(6, 6)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for k := 0; k+1 < len(order); k++ {
+        u, v := order[k], order[k+1]
+        a.Data[u][v], a.Data[v][u] = -1, -1
+        a.Data[u][u]++
+        a.Data[v][v]++
+    }
+    if width := a.bandwidth(); width != 4 {
+        t.Fatalf("expected original bandwidth 4, got %d", width)
+    }
+
+    reordered, perm, err := a.ReorderToBandwidth()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if err := validatePermutation(perm, a.Rows); err != nil {
+        t.Fatalf("invalid permutation %v: %v", perm, err)
+    }
+    if width := reordered.bandwidth(); width != 1 {
+        t.Fatalf("expected reordered bandwidth 1, got %d", width)
+    }
+
+    // The result is PAPᵀ for the returned permutation
+    for i := range perm {
+        for j := range perm {
+            if reordered.Data[i][j] != a.Data[perm[i]][perm[j]] {
+                t.Fatalf("expected entry (%d, %d) to be original (%d, %d)", i, j, perm[i], perm[j])
+            }
+        }
+    }
+
+    // An already tridiagonal matrix keeps its bandwidth
+    tri := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, 1, 0},
+            {1, 2, 1},
+            {0, 1, 2},
+        },
+    }
+    reordered, _, err = tri.ReorderToBandwidth()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if width := reordered.bandwidth(); width > tri.bandwidth() {
+        t.Fatalf("expected bandwidth at most %d, got %d", tri.bandwidth(), width)
+    }
+
+    nonSymmetric := Matrix{Rows: 2, Cols: 2, Data: [][]float64{{1, 2}, {0, 1}}}
+    if _, _, err = nonSymmetric.ReorderToBandwidth(); !errors.Is(err, ErrNotSymmetric) {
+        t.Fatalf("expected ErrNotSymmetric, got %v", err)
+    }
+}
+
+// TestBandMatrix tests band storage against the dense matrix it was built from.
+func TestBandMatrix(t *testing.T) {
+    // One diagonal below and two above the main diagonal
+    dense, err := NewMatrixFromFunc(6, 6, func(i, j int) float64 {
+        if j < i-1 || j > i+2 {
+            return 0
+        }
+        return float64(10*i + j + 1)
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    band, err := NewBandMatrix(dense, 1, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(band.Data[0]) != 4 {
+        t.Fatalf("expected 4 stored diagonals, got %d", len(band.Data[0]))
+    }
+    if back := band.ToDense(); !reflect.DeepEqual(back.Data, dense.Data) {
+        t.Fatalf("expected ToDense to give %v, got %v", dense.Data, back.Data)
+    }
+
+    v := []float64{1, -2, 0.5, 3, 0, -1}
+    got, err := band.MultiplyVector(v)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected, err := dense.MulVec(v)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(got, expected) {
+        t.Fatalf("expected %v, got %v", expected, got)
+    }
+
+    if _, err := band.MultiplyVector(v[:5]); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+    if _, err := NewBandMatrix(dense, 1, 1); !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange for an entry outside the band, got %v", err)
+    }
+}
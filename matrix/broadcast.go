@@ -0,0 +1,63 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// broadcastDim returns the size two dimensions broadcast to, and false if they are incompatible.
+func broadcastDim(a, b int) (int, bool) {
+    switch {
+    case a == b:
+        return a, true
+    case a == 1:
+        return b, true
+    case b == 1:
+        return a, true
+    }
+    return 0, false
+}
+
+// BroadcastShape returns the shape two matrices broadcast to under NumPy's rules: along each axis
+// the sizes must be equal or one of them must be 1, in which case it is stretched to match the other.
+// For example, a 1×3 row and a 2×1 column broadcast to 2×3, and a 1×1 matrix broadcasts to any shape.
+// Returns an error if the shapes are incompatible.
+func BroadcastShape(a, b Matrix) (rows, cols int, err error) {
+    rows, rowsOk := broadcastDim(a.Rows, b.Rows)
+    cols, colsOk := broadcastDim(a.Cols, b.Cols)
+    if !rowsOk || !colsOk {
+        return 0, 0, fmt.Errorf("%w: cannot broadcast %s with %s", ErrDimensionMismatch, shape(a), shape(b))
+    }
+    return rows, cols, nil
+}
+
+// AddRowVector returns a new matrix with v added to every row, as when adding a bias in a dense layer.
+// Returns an error if len(v) differs from Cols.
+func (m Matrix) AddRowVector(v []float64) (Matrix, error) {
+    if len(v) != m.Cols {
+        return Matrix{}, fmt.Errorf("%w: cannot add vector of length %d to rows of %s matrix", ErrDimensionMismatch, len(v), shape(m))
+    }
+
+    result := m.clone()
+    for _, row := range result.Data {
+        for j := range row {
+            row[j] += v[j]
+        }
+    }
+    return result, nil
+}
+
+// AddColVector returns a new matrix with v added to every column.
+// Returns an error if len(v) differs from Rows.
+func (m Matrix) AddColVector(v []float64) (Matrix, error) {
+    if len(v) != m.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot add vector of length %d to columns of %s matrix", ErrDimensionMismatch, len(v), shape(m))
+    }
+
+    result := m.clone()
+    for i, row := range result.Data {
+        for j := range row {
+            row[j] += v[i]
+        }
+    }
+    return result, nil
+}
@@ -0,0 +1,68 @@
+package matrix
+
+import (
+    "errors"
+    "testing"
+)
+
+func TestBroadcastShape(t *testing.T) {
+    scalar := Matrix{Rows: 1, Cols: 1, Data: [][]float64{{2}}}
+    full := Matrix{Rows: 2, Cols: 3, Data: [][]float64{{1, 2, 3}, {4, 5, 6}}}
+    row := Matrix{Rows: 1, Cols: 3, Data: [][]float64{{1, 2, 3}}}
+    column := Matrix{Rows: 4, Cols: 1, Data: [][]float64{{1}, {2}, {3}, {4}}}
+
+    tests := []struct {
+        a, b       Matrix
+        rows, cols int
+    }{
+        {scalar, full, 2, 3},
+        {full, scalar, 2, 3},
+        {row, column, 4, 3},
+        {full, row, 2, 3},
+    }
+
+    for _, test := range tests {
+        rows, cols, err := BroadcastShape(test.a, test.b)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if rows != test.rows || cols != test.cols {
+            t.Fatalf("expected %dx%d, got %dx%d", test.rows, test.cols, rows, cols)
+        }
+    }
+
+    _, _, err := BroadcastShape(full, column)
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+func TestAddRowVector(t *testing.T) {
+    a := Matrix{Rows: 2, Cols: 3, Data: [][]float64{{1, 2, 3}, {4, 5, 6}}}
+
+    result, err := a.AddRowVector([]float64{10, 20, 30})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, [][]float64{{11, 22, 33}, {14, 25, 36}}, result)
+
+    _, err = a.AddRowVector([]float64{1, 2})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+func TestAddColVector(t *testing.T) {
+    a := Matrix{Rows: 2, Cols: 3, Data: [][]float64{{1, 2, 3}, {4, 5, 6}}}
+
+    result, err := a.AddColVector([]float64{-1, 1})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, [][]float64{{0, 1, 2}, {5, 6, 7}}, result)
+
+    _, err = a.AddColVector([]float64{1, 2, 3})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
@@ -0,0 +1,117 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// MatrixBuilder accumulates rows one at a time for callers that do not know the row count up front,
+// such as when reading streaming data. The zero value is ready to use; the first row appended fixes
+// the number of columns.
+type MatrixBuilder struct {
+    cols int
+    rows [][]float64
+}
+
+// AppendRow adds a copy of row as the next row of the matrix.
+// Returns an error if row is empty or its length differs from the first row appended.
+func (b *MatrixBuilder) AppendRow(row []float64) error {
+    if len(row) == 0 {
+        return ErrInvalidDimensions
+    }
+    if len(b.rows) > 0 && len(row) != b.cols {
+        return fmt.Errorf("%w: expected row of %d columns, got %d", ErrDimensionMismatch, b.cols, len(row))
+    }
+
+    b.cols = len(row)
+    b.rows = append(b.rows, append([]float64(nil), row...))
+    return nil
+}
+
+// Build returns a new matrix holding the rows appended so far.
+// The builder can keep appending afterwards without affecting the returned matrix.
+// Returns an error if no rows have been appended.
+func (b *MatrixBuilder) Build() (Matrix, error) {
+    if len(b.rows) == 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+
+    result, err := NewZeroMatrix(len(b.rows), b.cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i, row := range b.rows {
+        copy(result.Data[i], row)
+    }
+
+    return result, nil
+}
+
+// Builder constructs a matrix cell by cell and returns it as an immutable matrix, for API boundaries
+// where a matrix must not change after construction. The zero value is ready to use. The shape is
+// inferred at Build time from the largest indices set, and every cell within it must have been set.
+type Builder struct {
+    cells      map[[2]int]float64
+    rows, cols int
+    err        error
+}
+
+// Set records value for the cell at row i and column j, replacing any earlier value for that cell.
+// A negative index is reported by Build.
+func (b *Builder) Set(i, j int, value float64) {
+    if i < 0 || j < 0 {
+        if b.err == nil {
+            b.err = fmt.Errorf("%w: index (%d, %d)", ErrOutOfRange, i, j)
+        }
+        return
+    }
+    if b.cells == nil {
+        b.cells = make(map[[2]int]float64)
+    }
+
+    b.cells[[2]int{i, j}] = value
+    b.rows = maxInt(b.rows, i+1)
+    b.cols = maxInt(b.cols, j+1)
+}
+
+// Build returns the matrix described by the cells set so far.
+// The result is immutable: in-place mutators such as Set, SwapRows, and ScaleRow return ErrImmutable,
+// and Fill and ApplyInPlace panic. Since it cannot change through those methods, caching of Determinant,
+// Inverse, and Rank is enabled. Writes made directly to Data cannot be prevented and must be avoided.
+// Methods that return a new matrix, such as T or Map, return ordinary mutable matrices.
+// Returns an error if nothing was set, a negative index was set, or any cell within the shape is missing.
+func (b *Builder) Build() (Matrix, error) {
+    if b.err != nil {
+        return Matrix{}, b.err
+    }
+    if len(b.cells) == 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+
+    result, err := NewZeroMatrix(b.rows, b.cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range result.Data {
+        for j := range result.Data[i] {
+            value, ok := b.cells[[2]int{i, j}]
+            if !ok {
+                return Matrix{}, fmt.Errorf("%w: cell (%d, %d) of %s matrix was never set", ErrIncomplete, i, j, shape(result))
+            }
+            result.Data[i][j] = value
+        }
+    }
+
+    result.EnableCache()
+    result.frozen = true
+    return result, nil
+}
+
+// Immutable reports whether the in-place mutators are disabled for the matrix, as they are for matrices
+// returned by Builder.Build.
+func (m Matrix) Immutable() bool {
+    return m.frozen
+}
@@ -0,0 +1,156 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "sync"
+    "testing"
+)
+
+// TestMatrixBuilder tests building a 3x2 matrix one row at a time.
+func TestMatrixBuilder(t *testing.T) {
+    var b MatrixBuilder
+
+    if _, err := b.Build(); !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions for an empty builder, got %v", err)
+    }
+
+    rows := [][]float64{
+        {1, 2},
+        {3, 4},
+        {5, 6},
+    }
+    for _, row := range rows {
+        if err := b.AppendRow(row); err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+    }
+
+    // Changing an appended slice must not change the result
+    rows[0][0] = 100
+
+    m, err := b.Build()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := Matrix{
+        Rows: 3,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+            {5, 6},
+        },
+    }
+    if !sameMatrix(m, expected) {
+        t.Fatalf("expected %v, got %v", expected, m)
+    }
+
+    err = b.AppendRow([]float64{7, 8, 9})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+// TestBuilder tests incomplete and complete builds and that the result rejects mutation.
+func TestBuilder(t *testing.T) {
+    var b Builder
+    b.Set(0, 0, 4)
+    b.Set(0, 1, 7)
+    b.Set(1, 1, 6)
+
+    _, err := b.Build()
+    if !errors.Is(err, ErrIncomplete) {
+        t.Fatalf("expected ErrIncomplete for missing cell (1, 0), got %v", err)
+    }
+
+    b.Set(1, 0, 2)
+    m, err := b.Build()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := [][]float64{
+        {4, 7},
+        {2, 6},
+    }
+    if !reflect.DeepEqual(m.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, m.Data)
+    }
+    if !m.Immutable() {
+        t.Fatal("expected built matrix to be immutable")
+    }
+
+    if err := m.Set(0, 0, 1); !errors.Is(err, ErrImmutable) {
+        t.Fatalf("expected ErrImmutable from Set, got %v", err)
+    }
+    if err := m.SwapRows(0, 1); !errors.Is(err, ErrImmutable) {
+        t.Fatalf("expected ErrImmutable from SwapRows, got %v", err)
+    }
+    func() {
+        defer func() {
+            if recover() == nil {
+                t.Fatal("expected Fill to panic on an immutable matrix")
+            }
+        }()
+        m.Fill(0)
+    }()
+    if !reflect.DeepEqual(m.Data, expected) {
+        t.Fatalf("expected rejected mutations to leave %v, got %v", expected, m.Data)
+    }
+
+    // Derived matrices are ordinary mutable values
+    transposed := m.T()
+    if transposed.Immutable() {
+        t.Fatal("expected transpose to be mutable")
+    }
+    if err := transposed.Set(0, 0, 1); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    det, err := m.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if det != 10 {
+        t.Fatalf("expected determinant 10, got %f", det)
+    }
+
+    var negative Builder
+    negative.Set(-1, 0, 1)
+    if _, err := negative.Build(); !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+}
+
+// TestBuilderSharedAcrossGoroutines tests that a built matrix can be queried from several goroutines.
+// Run with -race to check the cache it enables for data races.
+func TestBuilderSharedAcrossGoroutines(t *testing.T) {
+    var b Builder
+    b.Set(0, 0, 4)
+    b.Set(0, 1, 7)
+    b.Set(1, 0, 2)
+    b.Set(1, 1, 6)
+
+    m, err := b.Build()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    var wg sync.WaitGroup
+    dets := make([]float64, 8)
+    for g := range dets {
+        wg.Add(1)
+        go func(g int) {
+            defer wg.Done()
+            dets[g], _ = m.Determinant()
+            _, _ = m.Inverse()
+        }(g)
+    }
+    wg.Wait()
+
+    for _, det := range dets {
+        if det != 10 {
+            t.Fatalf("expected determinant 10 from every goroutine, got %v", dets)
+        }
+    }
+}
@@ -0,0 +1,94 @@
+package matrix
+
+import (
+    "fmt"
+    "sync"
+)
+
+// memoKey identifies a derived result stored in a matrix cache.
+type memoKey int
+
+const (
+    memoDeterminant memoKey = iota
+    memoInverse
+    memoRank
+)
+
+// memoEntry is a cached result along with the generation it was computed at.
+type memoEntry struct {
+    generation uint64
+    value      interface{}
+}
+
+// memo holds the generation counter and cached results of a matrix.
+// It is shared by every copy of the Matrix value, just like the Data rows are,
+// so mu guards it against concurrent queries on copies of the same matrix.
+type memo struct {
+    mu         sync.Mutex
+    generation uint64
+    entries    map[memoKey]memoEntry
+}
+
+// EnableCache turns on memoization of Determinant, Inverse, and Rank.
+// Results are keyed on a generation counter that the package's own in-place mutators
+// (such as Set, Fill, ApplyInPlace, and SwapRows) increment, so a repeated query on an
+// unmutated matrix is free while any mutation through those methods invalidates the cache.
+// Writes made directly to Data are not tracked; call EnableCache again afterwards to
+// discard any stale results.
+// The cache is safe for concurrent queries; concurrent mutation is not, just as for an uncached matrix.
+func (m *Matrix) EnableCache() {
+    m.memo = &memo{entries: make(map[memoKey]memoEntry)}
+}
+
+// Set assigns value to the element at row i and column j.
+// Returns an error if the indices are out of range or the matrix is immutable.
+func (m *Matrix) Set(i, j int, value float64) error {
+    if i < 0 || i >= m.Rows || j < 0 || j >= m.Cols {
+        return fmt.Errorf("%w: index (%d, %d) in %s matrix", ErrOutOfRange, i, j, shape(*m))
+    }
+    if err := m.touch(); err != nil {
+        return err
+    }
+    m.Data[i][j] = value
+    return nil
+}
+
+// touch is called by every in-place mutator before it writes. It advances the generation counter,
+// invalidating every cached result, or returns ErrImmutable if the matrix must not be mutated.
+func (m *Matrix) touch() error {
+    if m.frozen {
+        return ErrImmutable
+    }
+    if m.memo != nil {
+        m.memo.mu.Lock()
+        m.memo.generation++
+        m.memo.mu.Unlock()
+    }
+    return nil
+}
+
+// cached returns the cached result for key if it was computed at the current generation.
+func (m Matrix) cached(key memoKey) (interface{}, bool) {
+    if m.memo == nil {
+        return nil, false
+    }
+    m.memo.mu.Lock()
+    defer m.memo.mu.Unlock()
+
+    entry, ok := m.memo.entries[key]
+    if !ok || entry.generation != m.memo.generation {
+        return nil, false
+    }
+    return entry.value, true
+}
+
+// store records a result for key at the current generation when caching is enabled.
+func (m Matrix) store(key memoKey, value interface{}) {
+    if m.memo == nil {
+        return
+    }
+    m.memo.mu.Lock()
+    defer m.memo.mu.Unlock()
+
+    m.memo.entries[key] = memoEntry{generation: m.memo.generation, value: value}
+}
@@ -0,0 +1,117 @@
+package matrix
+
+import (
+    "math"
+    "sync"
+    "testing"
+)
+
+// TestCacheInvalidation tests that cached results are reused until the matrix is mutated.
+func TestCacheInvalidation(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+    a.EnableCache()
+
+    det, err := a.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(det+2) > 1e-9 {
+        t.Fatalf("expected determinant -2, got %f", det)
+    }
+
+    // Direct writes are not tracked, so the cached determinant is still returned
+    a.Data[0][0] = 5
+    det, err = a.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(det+2) > 1e-9 {
+        t.Fatalf("expected cached determinant -2, got %f", det)
+    }
+
+    err = a.Set(0, 0, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    det, err = a.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(det-2) > 1e-9 {
+        t.Fatalf("expected determinant 2 after Set, got %f", det)
+    }
+
+    inverse, err := a.Inverse()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    a.ApplyInPlace(func(x float64) float64 { return x * 2 })
+    scaled, err := a.Inverse()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(scaled.Data[0][0]-inverse.Data[0][0]/2) > 1e-9 {
+        t.Fatalf("expected inverse to be recomputed after ApplyInPlace, got %v", scaled.Data)
+    }
+
+    err = a.Set(2, 0, 1)
+    if err == nil {
+        t.Fatal("expected error for out-of-range index, but got none")
+    }
+}
+
+// TestCacheConcurrentQueries tests that goroutines can query one cached matrix at once.
+// Run with -race to check the cache for data races.
+func TestCacheConcurrentQueries(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, 1, 0},
+            {1, 3, 1},
+            {0, 1, 4},
+        },
+    }
+    a.EnableCache()
+
+    var wg sync.WaitGroup
+    errs := make(chan error, 8)
+    for g := 0; g < 8; g++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            for i := 0; i < 50; i++ {
+                if _, err := a.Determinant(); err != nil {
+                    errs <- err
+                    return
+                }
+                if _, err := a.Inverse(); err != nil {
+                    errs <- err
+                    return
+                }
+                a.Rank()
+            }
+        }()
+    }
+    wg.Wait()
+    close(errs)
+
+    for err := range errs {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    det, err := a.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(det-18) > 1e-9 {
+        t.Fatalf("expected determinant 18, got %f", det)
+    }
+}
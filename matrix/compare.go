@@ -0,0 +1,29 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+)
+
+// ApproxEqual reports whether a and b have the same shape and every pair of elements differs by at most tol.
+// Equal infinities and a pair of NaNs count as equal. When the matrices differ, the message describes
+// the shape mismatch or the first differing element in row-major order, ready to pass to t.Fatal;
+// otherwise it is empty.
+func ApproxEqual(a, b Matrix, tol float64) (bool, string) {
+    if a.Rows != b.Rows || a.Cols != b.Cols {
+        return false, fmt.Sprintf("shapes differ: %s and %s", shape(a), shape(b))
+    }
+
+    for i := range a.Data {
+        for j, x := range a.Data[i] {
+            y := b.Data[i][j]
+            if x == y || math.Abs(x-y) <= tol || (math.IsNaN(x) && math.IsNaN(y)) {
+                continue
+            }
+            return false, fmt.Sprintf("element (%d, %d) differs: %g and %g, difference %g exceeds tolerance %g",
+                i, j, x, y, math.Abs(x-y), tol)
+        }
+    }
+
+    return true, ""
+}
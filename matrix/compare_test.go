@@ -0,0 +1,49 @@
+package matrix
+
+import (
+    "math"
+    "strings"
+    "testing"
+)
+
+func TestApproxEqual(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {math.Inf(1), math.NaN()},
+        },
+    }
+
+    if ok, msg := ApproxEqual(a, a.clone(), 0); !ok {
+        t.Fatalf("expected a matrix to equal its copy: %s", msg)
+    }
+
+    nearby := a.clone()
+    nearby.Data[0][1] += 1e-10
+    if ok, msg := ApproxEqual(a, nearby, 1e-9); !ok || msg != "" {
+        t.Fatalf("expected matrices within tolerance to be equal, got %v: %s", ok, msg)
+    }
+
+    different := a.clone()
+    different.Data[0][1] = 2.5
+    ok, msg := ApproxEqual(a, different, 1e-9)
+    if ok {
+        t.Fatal("expected differing matrices not to be equal")
+    }
+    if !strings.Contains(msg, "(0, 1)") {
+        t.Fatalf("expected message to name element (0, 1), got %q", msg)
+    }
+
+    nan := a.clone()
+    nan.Data[0][0] = math.NaN()
+    if ok, _ := ApproxEqual(a, nan, 1e-9); ok {
+        t.Fatal("expected a number and NaN not to be equal")
+    }
+
+    ok, msg = ApproxEqual(a, Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}, 1e-9)
+    if ok || !strings.Contains(msg, "shapes differ") {
+        t.Fatalf("expected a shape mismatch message, got %v: %q", ok, msg)
+    }
+}
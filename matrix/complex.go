@@ -0,0 +1,132 @@
+package matrix
+
+import (
+    "fmt"
+    "math/cmplx"
+)
+
+// ComplexMatrix represents a matrix with complex128 elements
+type ComplexMatrix struct {
+    Rows int
+    Cols int
+    Data [][]complex128
+}
+
+// NewComplexMatrix creates a new ComplexMatrix
+// Returns an error if dimensions are not greater than 0 or data shape is mismatched
+func NewComplexMatrix(rows, cols int, data [][]complex128) (ComplexMatrix, error) {
+    if rows <= 0 || cols <= 0 {
+        return ComplexMatrix{}, ErrInvalidDimensions
+    }
+    if len(data) != rows {
+        return ComplexMatrix{}, fmt.Errorf("%w: expected %d rows of data, got %d", ErrDimensionMismatch, rows, len(data))
+    }
+    for _, row := range data {
+        if len(row) != cols {
+            return ComplexMatrix{}, fmt.Errorf("%w: expected %d columns of data, got %d", ErrDimensionMismatch, cols, len(row))
+        }
+    }
+    return ComplexMatrix{Rows: rows, Cols: cols, Data: data}, nil
+}
+
+// complexLU factors a square complex matrix into PA = LU using partial pivoting.
+// The layout of the result matches luDecompose: L and U are packed into one matrix,
+// perm[i] is the original row now in row i, and sign is the permutation's determinant.
+func complexLU(m ComplexMatrix) (lu [][]complex128, perm []int, sign complex128, singular bool) {
+    n := m.Rows
+    lu = make([][]complex128, n)
+    perm = make([]int, n)
+    for i := range m.Data {
+        lu[i] = make([]complex128, n)
+        copy(lu[i], m.Data[i])
+        perm[i] = i
+    }
+
+    sign = 1
+    for k := 0; k < n; k++ {
+        pivot := k
+        for i := k + 1; i < n; i++ {
+            if cmplx.Abs(lu[i][k]) > cmplx.Abs(lu[pivot][k]) {
+                pivot = i
+            }
+        }
+        if pivot != k {
+            lu[k], lu[pivot] = lu[pivot], lu[k]
+            perm[k], perm[pivot] = perm[pivot], perm[k]
+            sign = -sign
+        }
+
+        if cmplx.Abs(lu[k][k]) < pivotTolerance {
+            singular = true
+            continue
+        }
+
+        for i := k + 1; i < n; i++ {
+            lu[i][k] /= lu[k][k]
+            for j := k + 1; j < n; j++ {
+                lu[i][j] -= lu[i][k] * lu[k][j]
+            }
+        }
+    }
+
+    return lu, perm, sign, singular
+}
+
+// Determinant returns the determinant of a square complex matrix.
+// It is computed from an LU factorization with partial pivoting.
+// Returns an error if the matrix is not square.
+func (m ComplexMatrix) Determinant() (complex128, error) {
+    if m.Rows != m.Cols {
+        return 0, fmt.Errorf("%w: Determinant requires a square matrix, got %d×%d", ErrNotSquare, m.Rows, m.Cols)
+    }
+
+    lu, _, det, _ := complexLU(m)
+    for i := range lu {
+        det *= lu[i][i]
+    }
+
+    return det, nil
+}
+
+// Solve returns the matrix X satisfying AX = b, where each column of b is a right-hand side.
+// It uses an LU factorization with partial pivoting.
+// Returns an error if the matrix is not square, is singular, or b has the wrong number of rows.
+func (m ComplexMatrix) Solve(b ComplexMatrix) (ComplexMatrix, error) {
+    if m.Rows != m.Cols {
+        return ComplexMatrix{}, fmt.Errorf("%w: Solve requires a square matrix, got %d×%d", ErrNotSquare, m.Rows, m.Cols)
+    }
+    if b.Rows != m.Rows {
+        return ComplexMatrix{}, fmt.Errorf("%w: cannot solve %d×%d system with %d×%d right-hand side", ErrDimensionMismatch, m.Rows, m.Cols, b.Rows, b.Cols)
+    }
+
+    lu, perm, _, singular := complexLU(m)
+    if singular {
+        return ComplexMatrix{}, ErrSingular
+    }
+
+    n := m.Rows
+    x := make([][]complex128, n)
+    for i := range x {
+        x[i] = make([]complex128, b.Cols)
+    }
+
+    for c := 0; c < b.Cols; c++ {
+        // Forward substitution with the unit lower triangle
+        for i := 0; i < n; i++ {
+            x[i][c] = b.Data[perm[i]][c]
+            for j := 0; j < i; j++ {
+                x[i][c] -= lu[i][j] * x[j][c]
+            }
+        }
+
+        // Back substitution with the upper triangle
+        for i := n - 1; i >= 0; i-- {
+            for j := i + 1; j < n; j++ {
+                x[i][c] -= lu[i][j] * x[j][c]
+            }
+            x[i][c] /= lu[i][i]
+        }
+    }
+
+    return ComplexMatrix{Rows: n, Cols: b.Cols, Data: x}, nil
+}
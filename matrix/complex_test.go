@@ -0,0 +1,99 @@
+package matrix
+
+import (
+    "math/cmplx"
+    "testing"
+)
+
+func TestComplexDeterminant(t *testing.T) {
+    a := ComplexMatrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]complex128{
+            {1 + 1i, 2},
+            {1i, 3 - 1i},
+        },
+    }
+
+    // (1+i)(3-i) - 2i = 4 + 2i - 2i = 4
+    det, err := a.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if cmplx.Abs(det-4) > 1e-9 {
+        t.Fatalf("expected determinant 4, got %v", det)
+    }
+
+    c := ComplexMatrix{
+        Rows: 1,
+        Cols: 2,
+        Data: [][]complex128{
+            {1, 2},
+        },
+    }
+
+    _, err = c.Determinant()
+    if err == nil {
+        t.Fatal("expected error for non-square matrix, but got none")
+    }
+}
+
+func TestComplexSolve(t *testing.T) {
+    // x + i*y = 1 + 3i and x - y = -1 + i have the solution x = 1 + i, y = 2
+    a := ComplexMatrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]complex128{
+            {1, 1i},
+            {1, -1},
+        },
+    }
+    b := ComplexMatrix{
+        Rows: 2,
+        Cols: 1,
+        Data: [][]complex128{
+            {1 + 3i},
+            {-1 + 1i},
+        },
+    }
+    expected := []complex128{1 + 1i, 2}
+
+    x, err := a.Solve(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for i := range expected {
+        if cmplx.Abs(x.Data[i][0]-expected[i]) > 1e-9 {
+            t.Fatalf("expected %v, got %v", expected, x.Data)
+        }
+    }
+
+    singular := ComplexMatrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]complex128{
+            {1, 1i},
+            {2, 2i},
+        },
+    }
+
+    _, err = singular.Solve(b)
+    if err == nil {
+        t.Fatal("expected error for singular matrix, but got none")
+    }
+}
+
+func TestNewComplexMatrix(t *testing.T) {
+    _, err := NewComplexMatrix(2, 2, [][]complex128{{1, 2}, {3}})
+    if err == nil {
+        t.Fatal("expected error for rows with different lengths, but got none")
+    }
+
+    m, err := NewComplexMatrix(1, 2, [][]complex128{{1, 2i}})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if m.Rows != 1 || m.Cols != 2 {
+        t.Fatalf("expected dimensions (1, 2), got (%d, %d)", m.Rows, m.Cols)
+    }
+}
@@ -0,0 +1,35 @@
+package matrix
+
+import (
+    "context"
+    "fmt"
+)
+
+// ctxCheckRows is how many output rows the context-aware operations compute between cancellation checks.
+const ctxCheckRows = 8
+
+// MultiplyCtx performs matrix multiplication like Multiply, but stops early if ctx is cancelled.
+// The context is checked before every ctxCheckRows output rows, and its error is returned on cancellation.
+// Returns an error if matrices have incompatible dimensions.
+func (m Matrix) MultiplyCtx(ctx context.Context, other Matrix) (Matrix, error) {
+    if m.Cols != other.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot multiply %s by %s: inner dimensions %d and %d differ",
+            ErrDimensionMismatch, shape(m), shape(other), m.Cols, other.Rows)
+    }
+
+    result, err := NewZeroMatrix(m.Rows, other.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for start := 0; start < m.Rows; start += ctxCheckRows {
+        if err := ctx.Err(); err != nil {
+            return Matrix{}, err
+        }
+        end := minInt(start+ctxCheckRows, m.Rows)
+        multiplyBlocked(result.Data[start:end], m.Data[start:end], other.Data, MultiplyBlockSize)
+    }
+
+    return result, nil
+}
@@ -0,0 +1,64 @@
+package matrix
+
+import (
+    "context"
+    "errors"
+    "testing"
+)
+
+// TestMultiplyCtx tests that an uncancelled multiplication matches Multiply.
+func TestMultiplyCtx(t *testing.T) {
+    a := integerMatrix(20, 7)
+    b := integerMatrix(7, 5)
+
+    expected, err := a.Multiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    result, err := a.MultiplyCtx(context.Background(), b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, expected.Data, result)
+
+    _, err = a.MultiplyCtx(context.Background(), a)
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+// cancelAfter is a context that reports cancellation once Err has been checked a given number of times,
+// standing in for a cancel that arrives between row blocks.
+type cancelAfter struct {
+    context.Context
+    checks int
+}
+
+func (c *cancelAfter) Err() error {
+    if c.checks == 0 {
+        return context.Canceled
+    }
+    c.checks--
+    return nil
+}
+
+// TestMultiplyCtxCancel tests that a cancelled context stops the multiplication, both before it starts
+// and between row blocks.
+func TestMultiplyCtxCancel(t *testing.T) {
+    a := integerMatrix(4*ctxCheckRows, 8)
+    b := integerMatrix(8, 8)
+
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    _, err := a.MultiplyCtx(ctx, b)
+    if !errors.Is(err, context.Canceled) {
+        t.Fatalf("expected context.Canceled, got %v", err)
+    }
+
+    // Cancelled after two of the four row blocks
+    midway := &cancelAfter{Context: context.Background(), checks: 2}
+    _, err = a.MultiplyCtx(midway, b)
+    if !errors.Is(err, context.Canceled) {
+        t.Fatalf("expected context.Canceled, got %v", err)
+    }
+}
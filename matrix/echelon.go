@@ -0,0 +1,198 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+    "math/big"
+)
+
+// rrefPivots reduces a copy of the matrix to reduced row echelon form using Gauss-Jordan
+// elimination with partial pivoting. Entries smaller than tol are treated as zero.
+// Returns the reduced matrix along with the number of pivots found.
+func (m Matrix) rrefPivots(tol float64) (Matrix, int) {
+    result := m.clone()
+    data := result.Data
+
+    pivotRow := 0
+    for col := 0; col < m.Cols && pivotRow < m.Rows; col++ {
+        // Choose the row with the largest magnitude in this column as the pivot
+        best := pivotRow
+        for i := pivotRow + 1; i < m.Rows; i++ {
+            if math.Abs(data[i][col]) > math.Abs(data[best][col]) {
+                best = i
+            }
+        }
+        if math.Abs(data[best][col]) <= tol {
+            for i := pivotRow; i < m.Rows; i++ {
+                data[i][col] = 0
+            }
+            continue
+        }
+        data[pivotRow], data[best] = data[best], data[pivotRow]
+
+        pivot := data[pivotRow][col]
+        for j := col; j < m.Cols; j++ {
+            data[pivotRow][j] /= pivot
+        }
+
+        for i := 0; i < m.Rows; i++ {
+            if i == pivotRow {
+                continue
+            }
+            factor := data[i][col]
+            for j := col; j < m.Cols; j++ {
+                data[i][j] -= factor * data[pivotRow][j]
+                if math.Abs(data[i][j]) <= tol {
+                    data[i][j] = 0
+                }
+            }
+        }
+
+        pivotRow++
+    }
+
+    return result, pivotRow
+}
+
+// RREF returns the reduced row echelon form of the matrix.
+// It is computed via Gauss-Jordan elimination with partial pivoting, and entries that become
+// numerically tiny during elimination are set to exactly zero. The receiver is not modified.
+func (m Matrix) RREF() Matrix {
+    result, _ := m.rrefPivots(pivotTolerance)
+    return result
+}
+
+// rankTolerance is the magnitude below which an entry is treated as zero when computing Rank.
+const rankTolerance = 1e-10
+
+// Rank returns the number of linearly independent rows of the matrix.
+// It counts the pivots of the reduced row echelon form, treating entries within 1e-10 of zero as zero.
+// Use RankWithTolerance to treat nearly dependent rows as dependent.
+func (m Matrix) Rank() int {
+    if rank, ok := m.cached(memoRank); ok {
+        return rank.(int)
+    }
+
+    rank := m.RankWithTolerance(rankTolerance)
+
+    m.store(memoRank, rank)
+    return rank
+}
+
+// RankWithTolerance returns the number of linearly independent rows of the matrix, treating entries
+// within tol of zero as zero during elimination. Unlike Rank, the result is not cached.
+func (m Matrix) RankWithTolerance(tol float64) int {
+    _, rank := m.rrefPivots(tol)
+    return rank
+}
+
+// IsSingular reports whether a square matrix is singular, meaning it has fewer than Rows pivots when
+// entries within tol of zero are treated as zero. A rank check is used rather than the determinant,
+// whose magnitude depends on the scale of the matrix.
+// Returns an error if the matrix is not square.
+func (m Matrix) IsSingular(tol float64) (bool, error) {
+    if m.Rows != m.Cols {
+        return false, notSquare("IsSingular", m)
+    }
+
+    _, rank := m.rrefPivots(tol)
+    return rank < m.Rows, nil
+}
+
+// RankGF2 returns the rank of the matrix over the finite field GF(2).
+// Every entry must be exactly 0 or 1; elimination then uses XOR, so no rounding is involved.
+// The result can differ from Rank, which works over the real numbers.
+// Returns an error if any entry is not 0 or 1.
+func (m Matrix) RankGF2() (int, error) {
+    rows := make([][]bool, m.Rows)
+    for i := range m.Data {
+        rows[i] = make([]bool, m.Cols)
+        for j, val := range m.Data[i] {
+            if val != 0 && val != 1 {
+                return 0, fmt.Errorf("%w: entry (%d, %d) is %g, expected 0 or 1", ErrInvalidValue, i, j, val)
+            }
+            rows[i][j] = val == 1
+        }
+    }
+
+    rank := 0
+    for col := 0; col < m.Cols && rank < m.Rows; col++ {
+        pivot := -1
+        for i := rank; i < m.Rows; i++ {
+            if rows[i][col] {
+                pivot = i
+                break
+            }
+        }
+        if pivot < 0 {
+            continue
+        }
+        rows[rank], rows[pivot] = rows[pivot], rows[rank]
+
+        for i := range rows {
+            if i != rank && rows[i][col] {
+                for j := col; j < m.Cols; j++ {
+                    rows[i][j] = rows[i][j] != rows[rank][j]
+                }
+            }
+        }
+        rank++
+    }
+
+    return rank, nil
+}
+
+// RREFRational returns the reduced row echelon form of the matrix computed in exact rational arithmetic.
+// Each entry is converted to the rational number equal to its float64 value, which is exact for integers
+// and dyadic fractions but means 0.1 becomes the nearest binary fraction rather than 1/10.
+// No tolerance is involved: an entry is zero only if it is exactly zero.
+// Returns an error if any entry is NaN or infinite.
+func (m Matrix) RREFRational() ([][]big.Rat, error) {
+    rows := make([][]big.Rat, m.Rows)
+    for i := range m.Data {
+        rows[i] = make([]big.Rat, m.Cols)
+        for j, val := range m.Data[i] {
+            if math.IsNaN(val) || math.IsInf(val, 0) {
+                return nil, fmt.Errorf("%w: entry (%d, %d) is %g, expected a finite value", ErrInvalidValue, i, j, val)
+            }
+            rows[i][j].SetFloat64(val)
+        }
+    }
+
+    var factor, product big.Rat
+    pivotRow := 0
+    for col := 0; col < m.Cols && pivotRow < m.Rows; col++ {
+        // Any nonzero entry is an exact pivot, so take the first one
+        pivot := -1
+        for i := pivotRow; i < m.Rows; i++ {
+            if rows[i][col].Sign() != 0 {
+                pivot = i
+                break
+            }
+        }
+        if pivot < 0 {
+            continue
+        }
+        rows[pivotRow], rows[pivot] = rows[pivot], rows[pivotRow]
+
+        factor.Inv(&rows[pivotRow][col])
+        for j := col; j < m.Cols; j++ {
+            rows[pivotRow][j].Mul(&rows[pivotRow][j], &factor)
+        }
+
+        for i := range rows {
+            if i == pivotRow || rows[i][col].Sign() == 0 {
+                continue
+            }
+            factor.Set(&rows[i][col])
+            for j := col; j < m.Cols; j++ {
+                product.Mul(&factor, &rows[pivotRow][j])
+                rows[i][j].Sub(&rows[i][j], &product)
+            }
+        }
+
+        pivotRow++
+    }
+
+    return rows, nil
+}
@@ -0,0 +1,217 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+func TestRREF(t *testing.T) {
+    full := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, 1, -1},
+            {-3, -1, 2},
+            {-2, 1, 2},
+        },
+    }
+    assertClose(t, [][]float64{
+        {1, 0, 0},
+        {0, 1, 0},
+        {0, 0, 1},
+    }, full.RREF())
+
+    if full.Data[0][0] != 2 || full.Data[1][0] != -3 {
+        t.Fatalf("expected receiver to be unchanged, got %v", full.Data)
+    }
+
+    deficient := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {2, 4, 6},
+            {1, 0, 1},
+        },
+    }
+    assertClose(t, [][]float64{
+        {1, 0, 1},
+        {0, 1, 1},
+        {0, 0, 0},
+    }, deficient.RREF())
+
+    // Augmented system x + 2y + z = 4, 2x + y - z = 5
+    wide := Matrix{
+        Rows: 2,
+        Cols: 4,
+        Data: [][]float64{
+            {1, 2, 1, 4},
+            {2, 1, -1, 5},
+        },
+    }
+    assertClose(t, [][]float64{
+        {1, 0, -1, 2},
+        {0, 1, 1, 1},
+    }, wide.RREF())
+}
+
+func TestRank(t *testing.T) {
+    full := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, 1, -1},
+            {-3, -1, 2},
+            {-2, 1, 2},
+        },
+    }
+    if rank := full.Rank(); rank != 3 {
+        t.Fatalf("expected rank 3, got %d", rank)
+    }
+
+    dependent := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+            {5, 7, 9},
+        },
+    }
+    if rank := dependent.Rank(); rank != 2 {
+        t.Fatalf("expected rank 2, got %d", rank)
+    }
+
+    // Dependent up to a perturbation of 1e-6, which only a looser tolerance ignores
+    nearlyDependent := dependent.clone()
+    nearlyDependent.Data[2][2] += 1e-6
+    if rank := nearlyDependent.Rank(); rank != 3 {
+        t.Fatalf("expected rank 3, got %d", rank)
+    }
+    if rank := nearlyDependent.RankWithTolerance(1e-4); rank != 2 {
+        t.Fatalf("expected rank 2 with tolerance 1e-4, got %d", rank)
+    }
+
+    zero, err := NewZeroMatrix(2, 3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if rank := zero.Rank(); rank != 0 {
+        t.Fatalf("expected rank 0, got %d", rank)
+    }
+}
+
+// TestRankGF2 tests a binary matrix whose rank over GF(2) is lower than its real rank.
+func TestRankGF2(t *testing.T) {
+    // The third row is the XOR of the first two, but the rows are independent over the reals
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 1, 0},
+            {0, 1, 1},
+            {1, 0, 1},
+        },
+    }
+
+    rank, err := a.RankGF2()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if rank != 2 {
+        t.Fatalf("expected GF(2) rank 2, got %d", rank)
+    }
+    if realRank := a.Rank(); realRank != 3 {
+        t.Fatalf("expected real rank 3, got %d", realRank)
+    }
+
+    notBinary := Matrix{
+        Rows: 1,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+        },
+    }
+
+    _, err = notBinary.RankGF2()
+    if !errors.Is(err, ErrInvalidValue) {
+        t.Fatalf("expected ErrInvalidValue for non-binary entry, got %v", err)
+    }
+}
+
+// TestRREFRational tests that exact elimination produces fractions the float RREF can only approximate.
+func TestRREFRational(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 4,
+        Data: [][]float64{
+            {3, 1, 2, 1},
+            {1, 2, 0, 3},
+            {2, -1, 2, -2},
+        },
+    }
+
+    // The third row is the first minus the second
+    expected := [][]string{
+        {"1", "0", "4/5", "-1/5"},
+        {"0", "1", "-2/5", "8/5"},
+        {"0", "0", "0", "0"},
+    }
+
+    got, err := a.RREFRational()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(got) != len(expected) {
+        t.Fatalf("expected %d rows, got %d", len(expected), len(got))
+    }
+    for i := range expected {
+        for j := range expected[i] {
+            if got[i][j].RatString() != expected[i][j] {
+                t.Fatalf("expected %s at (%d, %d), got %s", expected[i][j], i, j, got[i][j].RatString())
+            }
+        }
+    }
+
+    _, err = Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, math.Inf(1)}}}.RREFRational()
+    if !errors.Is(err, ErrInvalidValue) {
+        t.Fatalf("expected ErrInvalidValue, got %v", err)
+    }
+}
+
+func TestIsSingular(t *testing.T) {
+    identity, err := NewIdentityMatrix(3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    singular, err := identity.IsSingular(1e-10)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if singular {
+        t.Fatal("expected identity not to be singular")
+    }
+
+    repeated := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+            {1, 2, 3},
+        },
+    }
+    singular, err = repeated.IsSingular(1e-10)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !singular {
+        t.Fatal("expected matrix with repeated rows to be singular")
+    }
+
+    _, err = Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}.IsSingular(1e-10)
+    if !errors.Is(err, ErrNotSquare) {
+        t.Fatalf("expected ErrNotSquare, got %v", err)
+    }
+}
@@ -0,0 +1,450 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+    "sort"
+)
+
+// startVector returns the deterministic starting vector used by the power iteration helpers.
+// A non-constant vector is used so it is unlikely to be orthogonal to the dominant eigenvector.
+func startVector(n int) []float64 {
+    v := make([]float64, n)
+    for i := range v {
+        v[i] = float64(i + 1)
+    }
+    normalize(v)
+    return v
+}
+
+// normalize scales v to unit length in place and returns its original length.
+func normalize(v []float64) float64 {
+    norm := 0.0
+    for _, x := range v {
+        norm += x * x
+    }
+    norm = math.Sqrt(norm)
+    if norm == 0 {
+        return 0
+    }
+    for i := range v {
+        v[i] /= norm
+    }
+    return norm
+}
+
+// matVec multiplies the matrix data by the vector v.
+func matVec(data [][]float64, v []float64) []float64 {
+    result := make([]float64, len(data))
+    for i, row := range data {
+        for j, val := range row {
+            result[i] += val * v[j]
+        }
+    }
+    return result
+}
+
+// powerIterate runs power iteration on a square matrix, returning the Rayleigh quotient estimate
+// of the largest-magnitude eigenvalue and a unit eigenvector.
+// The boolean reports whether successive estimates settled within tol before the iterations ran out.
+func powerIterate(data [][]float64, iterations int, tol float64) (float64, []float64, bool) {
+    v := startVector(len(data))
+    lambda := 0.0
+
+    for k := 0; k < iterations; k++ {
+        w := matVec(data, v)
+
+        // Rayleigh quotient of the current unit vector
+        next := 0.0
+        for i := range w {
+            next += v[i] * w[i]
+        }
+
+        if normalize(w) == 0 {
+            // v lies in the null space, so the dominant eigenvalue is zero
+            return 0, v, true
+        }
+        v = w
+
+        if k > 0 && math.Abs(next-lambda) <= tol {
+            return next, v, true
+        }
+        lambda = next
+    }
+
+    return lambda, v, false
+}
+
+// dominantEigenpair estimates the largest-magnitude eigenvalue and a unit eigenvector of a square matrix.
+// Returns an error if the estimate does not settle within tol after the given number of iterations.
+func dominantEigenpair(data [][]float64, iterations int, tol float64) (float64, []float64, error) {
+    lambda, v, converged := powerIterate(data, iterations, tol)
+    if !converged {
+        return 0, nil, fmt.Errorf("%w: power iteration stopped after %d iterations", ErrNoConvergence, iterations)
+    }
+    return lambda, v, nil
+}
+
+// SpectralGap returns the difference between the magnitudes of the two largest-magnitude eigenvalues.
+// The dominant eigenpair is found by power iteration and then removed with Wielandt deflation,
+// using the matching left eigenvector so that non-symmetric matrices such as Markov chains are supported.
+// Returns an error if the matrix is not square or if either power iteration does not converge.
+func (m Matrix) SpectralGap(iterations int, tol float64) (float64, error) {
+    if m.Rows != m.Cols {
+        return 0, notSquare("SpectralGap", m)
+    }
+
+    lambda1, right, err := dominantEigenpair(m.Data, iterations, tol)
+    if err != nil {
+        return 0, err
+    }
+    _, left, err := dominantEigenpair(m.T().Data, iterations, tol)
+    if err != nil {
+        return 0, err
+    }
+
+    scale := 0.0
+    for i := range left {
+        scale += left[i] * right[i]
+    }
+    if scale == 0 {
+        return 0, fmt.Errorf("%w: left and right dominant eigenvectors are orthogonal", ErrNoConvergence)
+    }
+
+    deflated, err := NewZeroMatrix(m.Rows, m.Cols)
+    if err != nil {
+        panic(err)
+    }
+    for i := range m.Data {
+        for j := range m.Data[0] {
+            deflated.Data[i][j] = m.Data[i][j] - lambda1*right[i]*left[j]/scale
+        }
+    }
+
+    lambda2, _, err := dominantEigenpair(deflated.Data, iterations, tol)
+    if err != nil {
+        return 0, err
+    }
+
+    return math.Abs(lambda1) - math.Abs(lambda2), nil
+}
+
+// NumericalRangeBound estimates the smallest and largest eigenvalues of the symmetric part (A+Aᵀ)/2.
+// These bound the real part of the field of values of A.
+// Power iteration first finds the eigenvalue of largest magnitude, then runs again on the matrix
+// shifted by that eigenvalue to find the opposite end of the spectrum.
+// Each run performs at most the given number of iterations and returns its best estimate.
+// Returns an error if the matrix is not square.
+func (m Matrix) NumericalRangeBound(iterations int) (low, high float64, err error) {
+    if m.Rows != m.Cols {
+        return 0, 0, notSquare("NumericalRangeBound", m)
+    }
+
+    symmetric, err := NewZeroMatrix(m.Rows, m.Cols)
+    if err != nil {
+        panic(err)
+    }
+    for i := range m.Data {
+        for j := range m.Data[0] {
+            symmetric.Data[i][j] = (m.Data[i][j] + m.Data[j][i]) / 2
+        }
+    }
+
+    dominant, _, _ := powerIterate(symmetric.Data, iterations, 0)
+
+    for i := range symmetric.Data {
+        symmetric.Data[i][i] -= dominant
+    }
+    shifted, _, _ := powerIterate(symmetric.Data, iterations, 0)
+    other := shifted + dominant
+
+    if other < dominant {
+        return other, dominant, nil
+    }
+    return dominant, other, nil
+}
+
+// symmetryTolerance is the largest difference between mirrored entries accepted as symmetric by the eigen solvers.
+const symmetryTolerance = 1e-9
+
+// jacobiMaxSweeps is the number of Jacobi sweeps allowed when EigenSymmetric is used internally.
+const jacobiMaxSweeps = 100
+
+// EigenSymmetric computes the eigenvalues and eigenvectors of a symmetric matrix using the cyclic Jacobi algorithm.
+// Each sweep applies a rotation to every off-diagonal pair, and iteration stops once the Frobenius norm of
+// the off-diagonal part is at most tol. Eigenvalues are returned in ascending order, and column i of vectors
+// is the unit eigenvector for values[i].
+// Returns an error if the matrix is not square, is not symmetric, or does not converge within maxIter sweeps.
+func (m Matrix) EigenSymmetric(tol float64, maxIter int) (values []float64, vectors Matrix, err error) {
+    if m.Rows != m.Cols {
+        return nil, Matrix{}, notSquare("EigenSymmetric", m)
+    }
+    if !m.IsSymmetric(symmetryTolerance) {
+        return nil, Matrix{}, fmt.Errorf("%w: EigenSymmetric requires a symmetric matrix", ErrNotSymmetric)
+    }
+
+    n := m.Rows
+    a := m.clone().Data
+    v, err := NewIdentityMatrix(n)
+    if err != nil {
+        panic(err)
+    }
+
+    converged := false
+    for sweep := 0; sweep <= maxIter; sweep++ {
+        off := 0.0
+        for p := 0; p < n; p++ {
+            for q := p + 1; q < n; q++ {
+                off += 2 * a[p][q] * a[p][q]
+            }
+        }
+        if math.Sqrt(off) <= tol {
+            converged = true
+            break
+        }
+        if sweep == maxIter {
+            break
+        }
+
+        for p := 0; p < n; p++ {
+            for q := p + 1; q < n; q++ {
+                if a[p][q] == 0 {
+                    continue
+                }
+
+                // Choose the rotation angle that zeroes a[p][q], taking the smaller root for stability
+                theta := (a[q][q] - a[p][p]) / (2 * a[p][q])
+                t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+                if theta < 0 {
+                    t = -t
+                }
+                c := 1 / math.Sqrt(t*t+1)
+                s := t * c
+
+                for k := 0; k < n; k++ {
+                    akp, akq := a[k][p], a[k][q]
+                    a[k][p] = c*akp - s*akq
+                    a[k][q] = s*akp + c*akq
+                }
+                for k := 0; k < n; k++ {
+                    apk, aqk := a[p][k], a[q][k]
+                    a[p][k] = c*apk - s*aqk
+                    a[q][k] = s*apk + c*aqk
+                }
+                for k := 0; k < n; k++ {
+                    vkp, vkq := v.Data[k][p], v.Data[k][q]
+                    v.Data[k][p] = c*vkp - s*vkq
+                    v.Data[k][q] = s*vkp + c*vkq
+                }
+            }
+        }
+    }
+    if !converged {
+        return nil, Matrix{}, fmt.Errorf("%w: Jacobi iteration stopped after %d sweeps", ErrNoConvergence, maxIter)
+    }
+
+    order := make([]int, n)
+    for i := range order {
+        order[i] = i
+    }
+    sort.Slice(order, func(i, j int) bool { return a[order[i]][order[i]] < a[order[j]][order[j]] })
+
+    values = make([]float64, n)
+    for i, k := range order {
+        values[i] = a[k][k]
+    }
+    vectors, err = v.PermuteColumns(order)
+    if err != nil {
+        panic(err)
+    }
+
+    return values, vectors, nil
+}
+
+// PowerIteration estimates the largest-magnitude eigenvalue and its eigenvector by repeatedly multiplying
+// a starting vector by the matrix and normalizing. Iteration stops once successive Rayleigh quotient
+// estimates differ by at most tol. The eigenvector is returned as a unit-length column matrix.
+// Returns an error if the matrix is not square or the estimate does not converge within iterations.
+func (m Matrix) PowerIteration(iterations int, tol float64) (eigenvalue float64, eigenvector Matrix, err error) {
+    if m.Rows != m.Cols {
+        return 0, Matrix{}, notSquare("PowerIteration", m)
+    }
+
+    eigenvalue, v, err := dominantEigenpair(m.Data, iterations, tol)
+    if err != nil {
+        return 0, Matrix{}, err
+    }
+
+    return eigenvalue, Vector(v).ToMatrixCol(), nil
+}
+
+// hessenberg returns a copy of the square matrix data reduced to upper Hessenberg form by Householder
+// similarity transforms. The result has the same eigenvalues and is zero below the first subdiagonal.
+func hessenberg(data [][]float64) [][]float64 {
+    n := len(data)
+    h := make([][]float64, n)
+    for i := range data {
+        h[i] = make([]float64, n)
+        copy(h[i], data[i])
+    }
+
+    for k := 0; k < n-2; k++ {
+        v := make([]float64, n-k-1)
+        for i := range v {
+            v[i] = h[k+1+i][k]
+        }
+        alpha := math.Sqrt(sumOfSquares(v))
+        if alpha == 0 {
+            continue
+        }
+        if v[0] > 0 {
+            alpha = -alpha
+        }
+        v[0] -= alpha
+        normalize(v)
+
+        // Apply the reflector I - 2vvᵀ from the left and then from the right
+        for j := k; j < n; j++ {
+            s := 0.0
+            for i := range v {
+                s += v[i] * h[k+1+i][j]
+            }
+            for i := range v {
+                h[k+1+i][j] -= 2 * v[i] * s
+            }
+        }
+        for i := 0; i < n; i++ {
+            s := 0.0
+            for l := range v {
+                s += h[i][k+1+l] * v[l]
+            }
+            for l := range v {
+                h[i][k+1+l] -= 2 * s * v[l]
+            }
+        }
+    }
+
+    return h
+}
+
+// eigenvalues2x2 returns the eigenvalues of [[a, b], [c, d]] and whether they are real.
+func eigenvalues2x2(a, b, c, d float64) (float64, float64, bool) {
+    mid := (a + d) / 2
+    disc := (a-d)*(a-d)/4 + b*c
+    if disc < 0 {
+        return 0, 0, false
+    }
+    root := math.Sqrt(disc)
+    return mid - root, mid + root, true
+}
+
+// EigenvaluesQR returns the real eigenvalues of a general square matrix in ascending order.
+// The matrix is first reduced to Hessenberg form, then shifted QR steps drive the subdiagonal to zero
+// from the bottom up. A subdiagonal entry counts as zero once it is at most tol times the magnitude of
+// its neighbouring diagonal entries. The iterations limit applies to the total number of QR steps.
+// Complex-conjugate pairs of eigenvalues are not returned in this version, so the result can be
+// shorter than the matrix dimension; use EigenSymmetric when the matrix is symmetric.
+// Returns an error if the matrix is not square or does not converge within the given iterations.
+func (m Matrix) EigenvaluesQR(iterations int, tol float64) ([]float64, error) {
+    if m.Rows != m.Cols {
+        return nil, notSquare("EigenvaluesQR", m)
+    }
+
+    h := hessenberg(m.Data)
+    values := make([]float64, 0, m.Rows)
+    steps := 0
+
+    for hi := m.Rows - 1; hi >= 0; {
+        // Find the start of the unreduced block that ends at row hi
+        lo := hi
+        for lo > 0 {
+            scale := math.Abs(h[lo][lo]) + math.Abs(h[lo-1][lo-1])
+            if scale == 0 {
+                scale = 1
+            }
+            if math.Abs(h[lo][lo-1]) <= tol*scale {
+                break
+            }
+            lo--
+        }
+
+        if lo == hi {
+            values = append(values, h[hi][hi])
+            hi--
+            continue
+        }
+        if lo == hi-1 {
+            // Solve 2×2 blocks directly; a complex pair is skipped
+            if low, high, isReal := eigenvalues2x2(h[lo][lo], h[lo][hi], h[hi][lo], h[hi][hi]); isReal {
+                values = append(values, low, high)
+            }
+            hi -= 2
+            continue
+        }
+
+        if steps == iterations {
+            return nil, fmt.Errorf("%w: QR iteration stopped after %d steps", ErrNoConvergence, iterations)
+        }
+        steps++
+
+        // Wilkinson shift: the eigenvalue of the trailing 2×2 block closest to the last diagonal entry
+        shift := h[hi][hi]
+        if low, high, isReal := eigenvalues2x2(h[hi-1][hi-1], h[hi-1][hi], h[hi][hi-1], h[hi][hi]); isReal {
+            shift = low
+            if math.Abs(high-h[hi][hi]) < math.Abs(low-h[hi][hi]) {
+                shift = high
+            }
+        }
+
+        // One QR step on the active block with Givens rotations: H - μI = QR, then H = RQ + μI
+        for i := lo; i <= hi; i++ {
+            h[i][i] -= shift
+        }
+        cs := make([]float64, hi-lo)
+        sn := make([]float64, hi-lo)
+        for k := lo; k < hi; k++ {
+            a, b := h[k][k], h[k+1][k]
+            r := math.Hypot(a, b)
+            c, s := 1.0, 0.0
+            if r != 0 {
+                c, s = a/r, b/r
+            }
+            cs[k-lo], sn[k-lo] = c, s
+            for j := k; j <= hi; j++ {
+                top, bottom := h[k][j], h[k+1][j]
+                h[k][j] = c*top + s*bottom
+                h[k+1][j] = -s*top + c*bottom
+            }
+        }
+        for k := lo; k < hi; k++ {
+            c, s := cs[k-lo], sn[k-lo]
+            for i := lo; i <= minInt(k+2, hi); i++ {
+                left, right := h[i][k], h[i][k+1]
+                h[i][k] = c*left + s*right
+                h[i][k+1] = -s*left + c*right
+            }
+        }
+        for i := lo; i <= hi; i++ {
+            h[i][i] += shift
+        }
+    }
+
+    sort.Float64s(values)
+    return values, nil
+}
+
+// IsPositiveSemidefinite reports whether a symmetric matrix has no eigenvalue below -tol.
+// Unlike positive definiteness, zero eigenvalues are allowed, so rank-deficient matrices such as
+// sample covariance matrices with fewer observations than variables are accepted.
+// Returns false for non-square or non-symmetric matrices.
+func (m Matrix) IsPositiveSemidefinite(tol float64) bool {
+    if m.Rows != m.Cols || !m.IsSymmetric(symmetryTolerance) {
+        return false
+    }
+
+    values, _, err := m.EigenSymmetric(1e-14*(1+m.FrobeniusNorm()), jacobiMaxSweeps)
+    if err != nil {
+        return false
+    }
+    return values[0] >= -tol
+}
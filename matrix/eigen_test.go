@@ -0,0 +1,348 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+// TestSpectralGap tests the spectral gap of a symmetric matrix and a Markov chain transition matrix.
+func TestSpectralGap(t *testing.T) {
+    // Eigenvalues 3 and 1
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {2, 1},
+            {1, 2},
+        },
+    }
+
+    gap, err := a.SpectralGap(1000, 1e-12)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(gap-2) > 1e-6 {
+        t.Fatalf("expected spectral gap 2, got %f", gap)
+    }
+
+    // Row-stochastic with eigenvalues 1 and 0.4
+    markov := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {0.9, 0.1},
+            {0.5, 0.5},
+        },
+    }
+
+    gap, err = markov.SpectralGap(1000, 1e-12)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(gap-0.6) > 1e-6 {
+        t.Fatalf("expected spectral gap 0.6, got %f", gap)
+    }
+
+    c := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    _, err = c.SpectralGap(1000, 1e-12)
+    if err == nil {
+        t.Fatal("expected error for non-square matrix, but got none")
+    }
+}
+
+// TestNumericalRangeBound tests the eigenvalue range of the symmetric part of a non-symmetric matrix.
+func TestNumericalRangeBound(t *testing.T) {
+    // Symmetric part is [[2, 1], [1, 2]] with eigenvalues 1 and 3
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {2, 3},
+            {-1, 2},
+        },
+    }
+
+    low, high, err := a.NumericalRangeBound(500)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(low-1) > 1e-6 || math.Abs(high-3) > 1e-6 {
+        t.Fatalf("expected range [1, 3], got [%f, %f]", low, high)
+    }
+
+    // Symmetric part has eigenvalues -4 and 1, so the dominant end is the low one
+    b := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {-4, 0},
+            {0, 1},
+        },
+    }
+
+    low, high, err = b.NumericalRangeBound(500)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(low+4) > 1e-6 || math.Abs(high-1) > 1e-6 {
+        t.Fatalf("expected range [-4, 1], got [%f, %f]", low, high)
+    }
+
+    c := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    _, _, err = c.NumericalRangeBound(500)
+    if err == nil {
+        t.Fatal("expected error for non-square matrix, but got none")
+    }
+}
+
+// TestEigenSymmetric tests the eigenpairs of a symmetric 2x2 matrix.
+func TestEigenSymmetric(t *testing.T) {
+    // Eigenvalues 1 and 3
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {2, 1},
+            {1, 2},
+        },
+    }
+
+    values, vectors, err := a.EigenSymmetric(1e-12, 50)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := []float64{1, 3}
+    for i := range expected {
+        if math.Abs(values[i]-expected[i]) > 1e-9 {
+            t.Fatalf("expected eigenvalues %v, got %v", expected, values)
+        }
+    }
+
+    for k, lambda := range values {
+        v := []float64{vectors.Data[0][k], vectors.Data[1][k]}
+        av, err := a.MulVec(v)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        for i := range v {
+            if math.Abs(av[i]-lambda*v[i]) > 1e-9 {
+                t.Fatalf("expected A*v = %f*v for v = %v, got %v", lambda, v, av)
+            }
+        }
+    }
+
+    nonSymmetric := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+
+    _, _, err = nonSymmetric.EigenSymmetric(1e-12, 50)
+    if !errors.Is(err, ErrNotSymmetric) {
+        t.Fatalf("expected ErrNotSymmetric, got %v", err)
+    }
+
+    _, _, err = Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}.EigenSymmetric(1e-12, 50)
+    if err == nil {
+        t.Fatal("expected error for non-square matrix, but got none")
+    }
+}
+
+// TestEigenSymmetricLarger tests that A*V = V*diag(values) for a 4x4 symmetric matrix.
+func TestEigenSymmetricLarger(t *testing.T) {
+    a := Matrix{
+        Rows: 4,
+        Cols: 4,
+        Data: [][]float64{
+            {4, 1, -2, 2},
+            {1, 2, 0, 1},
+            {-2, 0, 3, -2},
+            {2, 1, -2, -1},
+        },
+    }
+
+    values, vectors, err := a.EigenSymmetric(1e-12, 50)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    left, err := a.Multiply(vectors)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    right, err := vectors.Multiply(NewDiagonalMatrix(values))
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, right.Data, left)
+
+    for i := 1; i < len(values); i++ {
+        if values[i] < values[i-1] {
+            t.Fatalf("expected ascending eigenvalues, got %v", values)
+        }
+    }
+}
+
+// TestPowerIteration tests the dominant eigenpair of a matrix with eigenvalues 5 and 2.
+func TestPowerIteration(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {4, 1},
+            {2, 3},
+        },
+    }
+
+    eigenvalue, eigenvector, err := a.PowerIteration(1000, 1e-12)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(eigenvalue-5) > 1e-6 {
+        t.Fatalf("expected eigenvalue 5, got %f", eigenvalue)
+    }
+    if eigenvector.Rows != 2 || eigenvector.Cols != 1 {
+        t.Fatalf("expected a 2x1 eigenvector, got %dx%d", eigenvector.Rows, eigenvector.Cols)
+    }
+
+    // The eigenvector for 5 is proportional to (1, 1)
+    av, err := a.Multiply(eigenvector)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for i := range av.Data {
+        if math.Abs(av.Data[i][0]-eigenvalue*eigenvector.Data[i][0]) > 1e-5 {
+            t.Fatalf("expected A*v = %f*v, got %v for v = %v", eigenvalue, av.Data, eigenvector.Data)
+        }
+    }
+
+    _, _, err = a.PowerIteration(2, 1e-15)
+    if !errors.Is(err, ErrNoConvergence) {
+        t.Fatalf("expected ErrNoConvergence, got %v", err)
+    }
+
+    _, _, err = Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}.PowerIteration(100, 1e-9)
+    if !errors.Is(err, ErrNotSquare) {
+        t.Fatalf("expected ErrNotSquare, got %v", err)
+    }
+}
+
+// TestEigenvaluesQR tests a non-symmetric matrix with known real eigenvalues and one with a complex pair.
+func TestEigenvaluesQR(t *testing.T) {
+    // A = P D P⁻¹ with D = diag(-3, 1, 2, 5)
+    p := Matrix{
+        Rows: 4,
+        Cols: 4,
+        Data: [][]float64{
+            {1, 2, 0, 1},
+            {0, 1, 1, -1},
+            {2, 0, 1, 0},
+            {1, 1, 0, 3},
+        },
+    }
+    pInv, err := p.Inverse()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    pd, err := p.Multiply(NewDiagonalMatrix([]float64{-3, 1, 2, 5}))
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    a, err := pd.Multiply(pInv)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    values, err := a.EigenvaluesQR(500, 1e-14)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := []float64{-3, 1, 2, 5}
+    if len(values) != len(expected) {
+        t.Fatalf("expected eigenvalues %v, got %v", expected, values)
+    }
+    for i := range expected {
+        if math.Abs(values[i]-expected[i]) > 1e-8 {
+            t.Fatalf("expected eigenvalues %v, got %v", expected, values)
+        }
+    }
+
+    // Eigenvalues ±i and 2; only the real one is returned
+    rotation := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {0, -1, 0},
+            {1, 0, 0},
+            {0, 0, 2},
+        },
+    }
+    values, err = rotation.EigenvaluesQR(500, 1e-14)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if len(values) != 1 || math.Abs(values[0]-2) > 1e-9 {
+        t.Fatalf("expected eigenvalues [2], got %v", values)
+    }
+
+    _, err = Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}.EigenvaluesQR(500, 1e-14)
+    if !errors.Is(err, ErrNotSquare) {
+        t.Fatalf("expected ErrNotSquare, got %v", err)
+    }
+}
+
+// TestIsPositiveSemidefinite tests a rank-deficient PSD matrix, an indefinite matrix, and a non-symmetric one.
+func TestIsPositiveSemidefinite(t *testing.T) {
+    // vvᵀ for v = (1, 2, 3) has eigenvalues 0, 0 and 14
+    v := []float64{1, 2, 3}
+    rankOne := Outer(v, v)
+    if !rankOne.IsPositiveSemidefinite(1e-9) {
+        t.Fatal("expected rank-one outer product to be positive semidefinite")
+    }
+
+    // Eigenvalues 3 and -1
+    indefinite := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {2, 1},
+        },
+    }
+    if indefinite.IsPositiveSemidefinite(1e-9) {
+        t.Fatal("expected indefinite matrix not to be positive semidefinite")
+    }
+
+    nonSymmetric := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 1},
+            {0, 1},
+        },
+    }
+    if nonSymmetric.IsPositiveSemidefinite(1e-9) {
+        t.Fatal("expected non-symmetric matrix not to be positive semidefinite")
+    }
+}
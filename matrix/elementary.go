@@ -0,0 +1,110 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// ScaleRow multiplies every element of row i by factor, mutating the receiver.
+// Returns an error if the row index is out of range or the matrix is immutable.
+func (m *Matrix) ScaleRow(i int, factor float64) error {
+    if i < 0 || i >= m.Rows {
+        return fmt.Errorf("%w: row %d in %s matrix", ErrOutOfRange, i, shape(*m))
+    }
+
+    if err := m.touch(); err != nil {
+        return err
+    }
+    for j := range m.Data[i] {
+        m.Data[i][j] *= factor
+    }
+
+    return nil
+}
+
+// ScaleCol multiplies every element of column j by factor, mutating the receiver.
+// Returns an error if the column index is out of range or the matrix is immutable.
+func (m *Matrix) ScaleCol(j int, factor float64) error {
+    if j < 0 || j >= m.Cols {
+        return fmt.Errorf("%w: column %d in %s matrix", ErrOutOfRange, j, shape(*m))
+    }
+
+    if err := m.touch(); err != nil {
+        return err
+    }
+    for i := range m.Data {
+        m.Data[i][j] *= factor
+    }
+
+    return nil
+}
+
+// SwapRows exchanges rows i and j, mutating the receiver.
+// Returns an error if either row index is out of range or the matrix is immutable.
+func (m *Matrix) SwapRows(i, j int) error {
+    if i < 0 || i >= m.Rows || j < 0 || j >= m.Rows {
+        return fmt.Errorf("%w: rows %d and %d in %s matrix", ErrOutOfRange, i, j, shape(*m))
+    }
+
+    if err := m.touch(); err != nil {
+        return err
+    }
+    m.Data[i], m.Data[j] = m.Data[j], m.Data[i]
+
+    return nil
+}
+
+// AddScaledRow adds factor times row src to row dest, mutating the receiver.
+// Returns an error if either row index is out of range or the matrix is immutable.
+func (m *Matrix) AddScaledRow(dest, src int, factor float64) error {
+    if dest < 0 || dest >= m.Rows || src < 0 || src >= m.Rows {
+        return fmt.Errorf("%w: rows %d and %d in %s matrix", ErrOutOfRange, dest, src, shape(*m))
+    }
+
+    if err := m.touch(); err != nil {
+        return err
+    }
+    for j := range m.Data[dest] {
+        m.Data[dest][j] += factor * m.Data[src][j]
+    }
+
+    return nil
+}
+
+// SetRow overwrites row i with values, mutating the receiver.
+// The values are copied, so later changes to the slice do not affect the matrix.
+// Returns an error if the row index is out of range, len(values) differs from Cols, or the matrix is immutable.
+func (m *Matrix) SetRow(i int, values []float64) error {
+    if i < 0 || i >= m.Rows {
+        return fmt.Errorf("%w: row %d in %s matrix", ErrOutOfRange, i, shape(*m))
+    }
+    if len(values) != m.Cols {
+        return fmt.Errorf("%w: cannot set row of %s matrix from %d values", ErrDimensionMismatch, shape(*m), len(values))
+    }
+
+    if err := m.touch(); err != nil {
+        return err
+    }
+    copy(m.Data[i], values)
+
+    return nil
+}
+
+// SetCol overwrites column j with values, mutating the receiver.
+// Returns an error if the column index is out of range, len(values) differs from Rows, or the matrix is immutable.
+func (m *Matrix) SetCol(j int, values []float64) error {
+    if j < 0 || j >= m.Cols {
+        return fmt.Errorf("%w: column %d in %s matrix", ErrOutOfRange, j, shape(*m))
+    }
+    if len(values) != m.Rows {
+        return fmt.Errorf("%w: cannot set column of %s matrix from %d values", ErrDimensionMismatch, shape(*m), len(values))
+    }
+
+    if err := m.touch(); err != nil {
+        return err
+    }
+    for i := range m.Data {
+        m.Data[i][j] = values[i]
+    }
+
+    return nil
+}
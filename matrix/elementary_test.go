@@ -0,0 +1,202 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+func TestScaleRow(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    err := a.ScaleRow(1, -2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 2, 3},
+        {-8, -10, -12},
+    }
+    if !reflect.DeepEqual(a.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, a.Data)
+    }
+
+    err = a.ScaleRow(2, 3)
+    if err == nil {
+        t.Fatal("expected error for out-of-range row, but got none")
+    }
+}
+
+func TestScaleCol(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    err := a.ScaleCol(2, 0.5)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 2, 1.5},
+        {4, 5, 3},
+    }
+    if !reflect.DeepEqual(a.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, a.Data)
+    }
+
+    err = a.ScaleCol(-1, 3)
+    if err == nil {
+        t.Fatal("expected error for out-of-range column, but got none")
+    }
+}
+
+func TestSwapRows(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+            {5, 6},
+        },
+    }
+
+    err := a.SwapRows(0, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {5, 6},
+        {3, 4},
+        {1, 2},
+    }
+    if !reflect.DeepEqual(a.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, a.Data)
+    }
+
+    err = a.SwapRows(0, 3)
+    if err == nil {
+        t.Fatal("expected error for out-of-range row, but got none")
+    }
+}
+
+func TestAddScaledRow(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    err := a.AddScaledRow(1, 0, -4)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 2, 3},
+        {0, -3, -6},
+    }
+    if !reflect.DeepEqual(a.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, a.Data)
+    }
+
+    err = a.AddScaledRow(-1, 0, 1)
+    if err == nil {
+        t.Fatal("expected error for out-of-range destination row, but got none")
+    }
+
+    err = a.AddScaledRow(0, 2, 1)
+    if err == nil {
+        t.Fatal("expected error for out-of-range source row, but got none")
+    }
+}
+
+func TestSetRow(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    values := []float64{7, 8, 9}
+    err := a.SetRow(0, values)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    // Changing the source slice must not change the matrix
+    values[0] = 100
+
+    expected := [][]float64{
+        {7, 8, 9},
+        {4, 5, 6},
+    }
+    if !reflect.DeepEqual(a.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, a.Data)
+    }
+
+    err = a.SetRow(0, []float64{1, 2})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+
+    err = a.SetRow(2, []float64{1, 2, 3})
+    if !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+}
+
+func TestSetCol(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    err := a.SetCol(1, []float64{-1, -2})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, -1, 3},
+        {4, -2, 6},
+    }
+    if !reflect.DeepEqual(a.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, a.Data)
+    }
+
+    err = a.SetCol(1, []float64{1, 2, 3})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+
+    err = a.SetCol(-1, []float64{1, 2})
+    if !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+}
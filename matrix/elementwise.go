@@ -0,0 +1,91 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+    "math/rand"
+)
+
+// ReplaceNonFinite returns a copy of the matrix with every NaN, +Inf and -Inf replaced by replacement.
+// Finite values are left untouched.
+func (m Matrix) ReplaceNonFinite(replacement float64) Matrix {
+    return m.mapOrPanic(func(x float64) float64 {
+        if math.IsNaN(x) || math.IsInf(x, 0) {
+            return replacement
+        }
+        return x
+    })
+}
+
+// LogElementwise returns the natural logarithm of every element.
+// This is not the matrix logarithm. Instead of producing NaN or -Inf, it fails on the first
+// non-positive element, and the error reports that element's position.
+func (m Matrix) LogElementwise() (Matrix, error) {
+    return m.MapErr(func(x float64) (float64, error) {
+        if x <= 0 {
+            return 0, fmt.Errorf("%w: logarithm of non-positive value %g", ErrOutOfRange, x)
+        }
+        return math.Log(x), nil
+    })
+}
+
+// ExpElementwise returns e raised to every element. This is not the matrix exponential.
+func (m Matrix) ExpElementwise() Matrix {
+    return m.mapOrPanic(math.Exp)
+}
+
+// Perturb returns a copy of the matrix with independent uniform noise in [-magnitude, magnitude] added
+// to every element. Noise is drawn from src, so tests can reproduce a perturbation by reusing a seed.
+func (m Matrix) Perturb(magnitude float64, src rand.Source) Matrix {
+    rng := rand.New(src)
+    return m.mapOrPanic(func(x float64) float64 {
+        return x + magnitude*(2*rng.Float64()-1)
+    })
+}
+
+// mask returns a matrix holding 1 where keep is true for the element and 0 elsewhere.
+func (m Matrix) mask(keep func(float64) bool) Matrix {
+    return m.mapOrPanic(func(x float64) float64 {
+        if keep(x) {
+            return 1
+        }
+        return 0
+    })
+}
+
+// GreaterThan returns a mask holding 1 where the element is greater than threshold and 0 elsewhere.
+func (m Matrix) GreaterThan(threshold float64) Matrix {
+    return m.mask(func(x float64) bool { return x > threshold })
+}
+
+// LessThan returns a mask holding 1 where the element is less than threshold and 0 elsewhere.
+func (m Matrix) LessThan(threshold float64) Matrix {
+    return m.mask(func(x float64) bool { return x < threshold })
+}
+
+// EqualTo returns a mask holding 1 where the element is within tol of value and 0 elsewhere.
+func (m Matrix) EqualTo(value, tol float64) Matrix {
+    return m.mask(func(x float64) bool { return math.Abs(x-value) <= tol })
+}
+
+// Clamp returns a copy of the matrix with every element limited to the interval [min, max].
+// Returns an error if min is greater than max.
+func (m Matrix) Clamp(min, max float64) (Matrix, error) {
+    if min > max {
+        return Matrix{}, fmt.Errorf("%w: empty interval [%g, %g]", ErrOutOfRange, min, max)
+    }
+
+    return m.mapOrPanic(func(x float64) float64 {
+        return math.Max(min, math.Min(max, x))
+    }), nil
+}
+
+// Negate returns a new matrix with the sign of every element flipped. The receiver is not modified.
+func (m Matrix) Negate() Matrix {
+    return m.mapOrPanic(func(x float64) float64 { return -x })
+}
+
+// Abs returns a new matrix holding the absolute value of every element.
+func (m Matrix) Abs() Matrix {
+    return m.mapOrPanic(math.Abs)
+}
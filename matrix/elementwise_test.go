@@ -0,0 +1,192 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "math/rand"
+    "reflect"
+    "strings"
+    "testing"
+)
+
+func TestReplaceNonFinite(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, math.NaN(), -2.5},
+            {math.Inf(1), 0, math.Inf(-1)},
+        },
+    }
+
+    expected := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, -1, -2.5},
+            {-1, 0, -1},
+        },
+    }
+
+    result := a.ReplaceNonFinite(-1)
+    if !sameMatrix(result, expected) {
+        t.Fatalf("expected %v, got %v", expected, result)
+    }
+
+    if !math.IsNaN(a.Data[0][1]) {
+        t.Fatal("expected the original matrix to be unchanged")
+    }
+}
+
+func TestLogExpElementwise(t *testing.T) {
+    a := Matrix{
+        Rows: 1,
+        Cols: 3,
+        Data: [][]float64{
+            {1, math.E, 0.5},
+        },
+    }
+
+    logs, err := a.LogElementwise()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, [][]float64{{0, 1, -math.Ln2}}, logs)
+    assertClose(t, a.Data, logs.ExpElementwise())
+
+    zero := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {0, 3},
+        },
+    }
+
+    _, err = zero.LogElementwise()
+    if !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+    if !strings.Contains(err.Error(), "(1, 0)") {
+        t.Fatalf("expected error to report position (1, 0), got %v", err)
+    }
+}
+
+func TestPerturb(t *testing.T) {
+    a, err := NewMatrixFromFunc(4, 5, func(i, j int) float64 { return float64(i - j) })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    magnitude := 0.01
+    perturbed := a.Perturb(magnitude, rand.NewSource(42))
+
+    changed := false
+    for i := range a.Data {
+        for j := range a.Data[i] {
+            diff := math.Abs(perturbed.Data[i][j] - a.Data[i][j])
+            if diff > magnitude {
+                t.Fatalf("expected perturbation at most %g, got %g at (%d, %d)", magnitude, diff, i, j)
+            }
+            if diff > 0 {
+                changed = true
+            }
+        }
+    }
+    if !changed {
+        t.Fatal("expected the perturbed matrix to differ from the original")
+    }
+
+    // The same seed reproduces the same perturbation
+    if again := a.Perturb(magnitude, rand.NewSource(42)); !reflect.DeepEqual(again.Data, perturbed.Data) {
+        t.Fatal("expected the same source seed to give the same perturbation")
+    }
+}
+
+func TestComparisonMasks(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {-1, 0, 2},
+            {2 + 1e-12, 5, math.NaN()},
+        },
+    }
+
+    // NaN compares false, so it is never part of a mask
+    assertClose(t, [][]float64{{0, 0, 0}, {1, 1, 0}}, a.GreaterThan(2))
+    assertClose(t, [][]float64{{1, 1, 0}, {0, 0, 0}}, a.LessThan(2))
+    assertClose(t, [][]float64{{0, 0, 1}, {1, 0, 0}}, a.EqualTo(2, 1e-9))
+    assertClose(t, [][]float64{{0, 0, 1}, {0, 0, 0}}, a.EqualTo(2, 0))
+}
+
+func TestClamp(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {-5, 0, 0.5},
+            {1, 7, math.Inf(1)},
+        },
+    }
+
+    result, err := a.Clamp(0, 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := [][]float64{
+        {0, 0, 0.5},
+        {1, 1, 1},
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    _, err = a.Clamp(1, 0)
+    if !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+}
+
+func TestNegate(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, -2},
+            {0, 3.5},
+        },
+    }
+
+    assertClose(t, [][]float64{{-1, 2}, {0, -3.5}}, a.Negate())
+    if a.Data[0][0] != 1 {
+        t.Fatal("expected the original matrix to be unchanged")
+    }
+
+    zero, err := NewZeroMatrix(2, 3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, zero.Data, zero.Negate())
+}
+
+func TestAbs(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {-1, 2, -0.25},
+            {0, -7, 3},
+        },
+    }
+
+    result := a.Abs()
+    assertClose(t, [][]float64{{1, 2, 0.25}, {0, 7, 3}}, result)
+    for _, row := range result.Data {
+        for _, val := range row {
+            if val < 0 {
+                t.Fatalf("expected every entry to be non-negative, got %v", result.Data)
+            }
+        }
+    }
+}
@@ -0,0 +1,57 @@
+package matrix
+
+import (
+    "errors"
+    "fmt"
+)
+
+// Sentinel errors returned by the package, wrapped with details about the failure.
+// Use errors.Is to check for them.
+var (
+    // ErrDimensionMismatch is returned when the dimensions of the operands are incompatible.
+    ErrDimensionMismatch = errors.New("dimension mismatch")
+    // ErrInvalidDimensions is returned when a matrix would have a non-positive number of rows or columns.
+    ErrInvalidDimensions = errors.New("dimensions must be positive integers")
+    // ErrNotSquare is returned when an operation requires a square matrix.
+    ErrNotSquare = errors.New("matrix is not square")
+    // ErrNotSymmetric is returned when an operation requires a symmetric matrix.
+    ErrNotSymmetric = errors.New("matrix is not symmetric")
+    // ErrSingular is returned when an operation requires an invertible matrix.
+    ErrSingular = errors.New("matrix is singular")
+    // ErrDivisionByZero is returned when an element-wise division has a zero divisor.
+    ErrDivisionByZero = errors.New("division by zero")
+    // ErrOutOfRange is returned when an index or argument is outside its valid range.
+    ErrOutOfRange = errors.New("out of range")
+    // ErrInvalidPermutation is returned when a permutation repeats an index.
+    ErrInvalidPermutation = errors.New("invalid permutation")
+    // ErrNotStochastic is returned when an operation requires a row-stochastic matrix.
+    ErrNotStochastic = errors.New("matrix is not row-stochastic")
+    // ErrInvalidValue is returned when an argument or element has a value the operation cannot accept.
+    ErrInvalidValue = errors.New("invalid value")
+    // ErrNoConvergence is returned when an iterative method does not converge within its iteration limit.
+    ErrNoConvergence = errors.New("did not converge")
+    // ErrImmutable is returned when an in-place mutator is called on an immutable matrix.
+    ErrImmutable = errors.New("matrix is immutable")
+    // ErrIncomplete is returned when a Builder is built before every cell within its shape was set.
+    ErrIncomplete = errors.New("matrix is incomplete")
+)
+
+// shape formats the dimensions of a matrix for error messages.
+func shape(m Matrix) string {
+    return formatShape(m.Rows, m.Cols)
+}
+
+// formatShape formats rows and cols as shape does, for types other than Matrix such as GenericMatrix.
+func formatShape(rows, cols int) string {
+    return fmt.Sprintf("%d×%d", rows, cols)
+}
+
+// notSquare reports that op was given a non-square matrix.
+func notSquare(op string, m Matrix) error {
+    return fmt.Errorf("%w: %s requires a square matrix, got %s", ErrNotSquare, op, shape(m))
+}
+
+// elementwiseMismatch reports that two matrices needed identical shapes for an element-wise operation.
+func elementwiseMismatch(op string, a, b Matrix) error {
+    return fmt.Errorf("%w: %s requires matching shapes, got %s and %s", ErrDimensionMismatch, op, shape(a), shape(b))
+}
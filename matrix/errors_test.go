@@ -0,0 +1,65 @@
+package matrix
+
+import (
+    "errors"
+    "testing"
+)
+
+// TestSentinelErrors tests that errors from the package can be matched with errors.Is.
+func TestSentinelErrors(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+    singular := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {2, 4},
+        },
+    }
+
+    _, err := a.Add(a.T())
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch from Add, got %v", err)
+    }
+
+    _, err = a.Multiply(a)
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch from Multiply, got %v", err)
+    }
+
+    _, err = NewMatrix(2, 2, [][]float64{{1, 2}, {3}})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch from NewMatrix, got %v", err)
+    }
+
+    _, err = NewMatrix(0, 2, [][]float64{})
+    if !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions from NewMatrix, got %v", err)
+    }
+
+    _, err = a.Determinant()
+    if !errors.Is(err, ErrNotSquare) {
+        t.Fatalf("expected ErrNotSquare from Determinant, got %v", err)
+    }
+
+    _, err = singular.Inverse()
+    if !errors.Is(err, ErrSingular) {
+        t.Fatalf("expected ErrSingular from Inverse, got %v", err)
+    }
+
+    err = a.SwapRows(0, 2)
+    if !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange from SwapRows, got %v", err)
+    }
+
+    if errors.Is(err, ErrDimensionMismatch) {
+        t.Fatal("expected ErrOutOfRange not to match ErrDimensionMismatch")
+    }
+}
@@ -0,0 +1,56 @@
+package matrix
+
+// newData allocates the rows of a rows x cols matrix as consecutive windows of one flat backing array,
+// which it also returns. Keeping every element in a single allocation lets whole-matrix operations iterate
+// contiguously. Each row's capacity ends where the next row starts, so appending to a row reallocates it
+// instead of overwriting its neighbour.
+func newData[T Numeric](rows, cols int) ([][]T, []T) {
+    backing := make([]T, rows*cols)
+    data := make([][]T, rows)
+    for i := range data {
+        data[i] = backing[i*cols : (i+1)*cols : (i+1)*cols]
+    }
+    return data, backing
+}
+
+// contiguous returns the flat backing array of the matrix if its rows are still laid out back to back
+// in it, as they are for matrices created by this package.
+// The boolean is false when the rows were allocated separately, e.g. by a caller of NewMatrix, or when
+// a row has since been replaced or reordered.
+func (m Matrix) contiguous() ([]float64, bool) {
+    if m.Rows == 0 || len(m.backing) != m.Rows*m.Cols || len(m.Data) != m.Rows {
+        return nil, false
+    }
+
+    for i, row := range m.Data {
+        if len(row) != m.Cols || &m.backing[i*m.Cols] != &row[0] {
+            return nil, false
+        }
+    }
+    return m.backing, true
+}
+
+// eachRun calls f on runs of elements that together cover the matrix in row-major order: the whole backing
+// array once for a contiguous matrix, otherwise each row in turn. Nothing is copied, so f must not modify
+// the runs.
+func (m Matrix) eachRun(f func([]float64)) {
+    if backing, ok := m.contiguous(); ok {
+        f(backing)
+        return
+    }
+    for _, row := range m.Data {
+        f(row)
+    }
+}
+
+// ToFlat returns the elements of the matrix in a single row-major slice along with its stride.
+// The stride is the distance between the starts of consecutive rows, so element (i, j) is data[i*stride+j].
+// Matrices created by this package already keep their rows in one flat backing array; for those the
+// returned slice shares storage with Data and writes through either are visible in both. Matrices whose
+// rows were allocated separately are copied into a new slice instead.
+func (m Matrix) ToFlat() (data []float64, stride int) {
+    if backing, ok := m.contiguous(); ok {
+        return backing, m.Cols
+    }
+    return m.Flatten(), m.Cols
+}
@@ -0,0 +1,145 @@
+package matrix
+
+import (
+    "reflect"
+    "testing"
+)
+
+// TestToFlat tests the flat layout of contiguous and separately allocated matrices.
+func TestToFlat(t *testing.T) {
+    contiguous, err := NewZeroMatrix(2, 3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for i := range contiguous.Data {
+        for j := range contiguous.Data[i] {
+            contiguous.Data[i][j] = float64(i*3 + j)
+        }
+    }
+
+    data, stride := contiguous.ToFlat()
+    if stride != 3 {
+        t.Fatalf("expected stride 3, got %d", stride)
+    }
+    if !reflect.DeepEqual(data, []float64{0, 1, 2, 3, 4, 5}) {
+        t.Fatalf("expected [0 1 2 3 4 5], got %v", data)
+    }
+
+    // Element (1, 2) lives at 1*stride+2 and is shared with Data
+    data[1*stride+2] = 50
+    if contiguous.Data[1][2] != 50 {
+        t.Fatalf("expected flat data to share storage, got %v", contiguous.Data)
+    }
+
+    separate := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+
+    data, stride = separate.ToFlat()
+    if stride != 2 || !reflect.DeepEqual(data, []float64{1, 2, 3, 4}) {
+        t.Fatalf("expected [1 2 3 4] with stride 2, got %v with stride %d", data, stride)
+    }
+    data[0] = 100
+    if separate.Data[0][0] != 1 {
+        t.Fatalf("expected separately allocated matrix to be copied, got %v", separate.Data)
+    }
+}
+
+// sameMatrix reports whether a and b have the same shape and elements, ignoring storage details such as
+// the flat backing array and the cache.
+func sameMatrix(a, b Matrix) bool {
+    return a.Rows == b.Rows && a.Cols == b.Cols && reflect.DeepEqual(a.Data, b.Data)
+}
+
+// TestRowAppend tests that appending to a row of a flat-backed matrix leaves the next row intact.
+func TestRowAppend(t *testing.T) {
+    m, err := NewZeroMatrix(3, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    extended := append(m.Data[0], 99)
+    if !reflect.DeepEqual(m.Data[1], []float64{0, 0}) {
+        t.Fatalf("expected row 1 to stay [0 0] after appending to row 0, got %v", m.Data[1])
+    }
+    if !reflect.DeepEqual(extended, []float64{0, 0, 99}) {
+        t.Fatalf("expected [0 0 99], got %v", extended)
+    }
+
+    // Replacing a row breaks the flat layout, so the matrix is no longer treated as contiguous
+    m.Data[0] = extended[:2]
+    if _, ok := m.contiguous(); ok {
+        t.Fatal("expected matrix with a replaced row not to be contiguous")
+    }
+    m.Data[0][0] = 4
+    if sum := m.Sum(); sum != 4 {
+        t.Fatalf("expected sum 4, got %f", sum)
+    }
+}
+
+// TestSumAllocations tests that the whole-matrix reductions do not copy the elements.
+func TestSumAllocations(t *testing.T) {
+    flat, err := NewZeroMatrix(8, 8)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    separate := separateRows(flat)
+
+    for _, m := range []Matrix{flat, separate} {
+        allocs := testing.AllocsPerRun(10, func() {
+            _ = m.Sum()
+            _ = m.Min()
+            _ = m.Max()
+            _ = m.FrobeniusNorm()
+        })
+        if allocs != 0 {
+            t.Fatalf("expected no allocations, got %v", allocs)
+        }
+    }
+}
+
+// separateRows returns a copy of m whose rows are allocated one at a time, as for a matrix passed to NewMatrix.
+func separateRows(m Matrix) Matrix {
+    data := make([][]float64, m.Rows)
+    for i := range data {
+        data[i] = make([]float64, m.Cols)
+        copy(data[i], m.Data[i])
+    }
+    return Matrix{Rows: m.Rows, Cols: m.Cols, Data: data}
+}
+
+func BenchmarkSumContiguous(b *testing.B) {
+    m, err := NewRandomMatrix(1024, 256, -1, 1)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+    if _, ok := m.contiguous(); !ok {
+        b.Fatal("expected NewRandomMatrix to return a contiguous matrix")
+    }
+
+    b.ResetTimer()
+    for n := 0; n < b.N; n++ {
+        _ = m.Sum()
+    }
+}
+
+func BenchmarkSumSeparateRows(b *testing.B) {
+    m, err := NewRandomMatrix(1024, 256, -1, 1)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+    separate := separateRows(m)
+    if _, ok := separate.contiguous(); ok {
+        b.Fatal("expected separately allocated rows not to be contiguous")
+    }
+
+    b.ResetTimer()
+    for n := 0; n < b.N; n++ {
+        _ = separate.Sum()
+    }
+}
@@ -0,0 +1,129 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// Numeric is the set of element types supported by GenericMatrix.
+type Numeric interface {
+    ~int | ~int8 | ~int16 | ~int32 | ~int64 |
+        ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+        ~float32 | ~float64
+}
+
+// GenericMatrix is a matrix over any Numeric element type, such as int or float32.
+// It supports the core Add, Multiply, and Map operations. Matrix remains the float64 type
+// that the rest of the package is built on; use ToMatrix and NewGenericFromMatrix to convert.
+type GenericMatrix[T Numeric] struct {
+    Rows int
+    Cols int
+    Data [][]T
+}
+
+// Float64Matrix is GenericMatrix over float64, kept for backward compatibility with code written
+// against the generic API before other element types existed. Use ToMatrix to reach the full Matrix API.
+type Float64Matrix = GenericMatrix[float64]
+
+// NewGenericMatrix creates a new GenericMatrix from data, validating the dimensions like NewMatrix.
+// Returns an error if dimensions are not greater than 0 or data shape is mismatched.
+func NewGenericMatrix[T Numeric](rows, cols int, data [][]T) (GenericMatrix[T], error) {
+    if rows <= 0 || cols <= 0 {
+        return GenericMatrix[T]{}, ErrInvalidDimensions
+    }
+    if len(data) != rows {
+        return GenericMatrix[T]{}, fmt.Errorf("%w: expected %d rows of data, got %d", ErrDimensionMismatch, rows, len(data))
+    }
+    for _, row := range data {
+        if len(row) != cols {
+            return GenericMatrix[T]{}, fmt.Errorf("%w: expected %d columns of data, got %d", ErrDimensionMismatch, cols, len(row))
+        }
+    }
+    return GenericMatrix[T]{Rows: rows, Cols: cols, Data: data}, nil
+}
+
+// newGenericZero allocates a zeroed GenericMatrix with one flat backing array, like NewZeroMatrix.
+func newGenericZero[T Numeric](rows, cols int) GenericMatrix[T] {
+    data, _ := newData[T](rows, cols)
+    return GenericMatrix[T]{Rows: rows, Cols: cols, Data: data}
+}
+
+// NewGenericFromMatrix converts a float64 Matrix to a GenericMatrix, using Go's conversion rules,
+// so converting to an integer type truncates toward zero.
+func NewGenericFromMatrix[T Numeric](m Matrix) GenericMatrix[T] {
+    result := newGenericZero[T](m.Rows, m.Cols)
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            result.Data[i][j] = T(val)
+        }
+    }
+    return result
+}
+
+// ToMatrix converts the matrix to a float64 Matrix so the rest of the package can be used on it.
+func (m GenericMatrix[T]) ToMatrix() Matrix {
+    result, err := NewZeroMatrix(m.Rows, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            result.Data[i][j] = float64(val)
+        }
+    }
+
+    return result
+}
+
+// Add returns the element-wise sum of two matrices.
+// Integer types wrap around on overflow as usual in Go.
+// Returns an error if the matrices have different dimensions.
+func (m GenericMatrix[T]) Add(other GenericMatrix[T]) (GenericMatrix[T], error) {
+    if m.Rows != other.Rows || m.Cols != other.Cols {
+        return GenericMatrix[T]{}, fmt.Errorf("%w: Add requires matching shapes, got %s and %s",
+            ErrDimensionMismatch, formatShape(m.Rows, m.Cols), formatShape(other.Rows, other.Cols))
+    }
+
+    result := newGenericZero[T](m.Rows, m.Cols)
+    for i := range m.Data {
+        for j := range m.Data[i] {
+            result.Data[i][j] = m.Data[i][j] + other.Data[i][j]
+        }
+    }
+
+    return result, nil
+}
+
+// Multiply performs matrix multiplication between two matrices.
+// Products are accumulated in T, so integer types wrap around on overflow.
+// Returns an error if the matrices have incompatible dimensions.
+func (m GenericMatrix[T]) Multiply(other GenericMatrix[T]) (GenericMatrix[T], error) {
+    if m.Cols != other.Rows {
+        return GenericMatrix[T]{}, fmt.Errorf("%w: cannot multiply %s by %s: inner dimensions %d and %d differ",
+            ErrDimensionMismatch, formatShape(m.Rows, m.Cols), formatShape(other.Rows, other.Cols), m.Cols, other.Rows)
+    }
+
+    result := newGenericZero[T](m.Rows, other.Cols)
+    for i := range m.Data {
+        for k, a := range m.Data[i] {
+            for j, b := range other.Data[k] {
+                result.Data[i][j] += a * b
+            }
+        }
+    }
+
+    return result, nil
+}
+
+// Map applies a function to all the elements in a matrix, returning a new matrix.
+// The signature matches Matrix.Map.
+func (m GenericMatrix[T]) Map(f func(T) T) (GenericMatrix[T], error) {
+    result := newGenericZero[T](m.Rows, m.Cols)
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            result.Data[i][j] = f(val)
+        }
+    }
+    return result, nil
+}
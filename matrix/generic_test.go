@@ -0,0 +1,122 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+func TestGenericMatrixInt(t *testing.T) {
+    a, err := NewGenericMatrix(2, 2, [][]int{
+        {1, 2},
+        {3, 4},
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    b, err := NewGenericMatrix(2, 2, [][]int{
+        {5, 6},
+        {7, 8},
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    sum, err := a.Add(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if expected := [][]int{{6, 8}, {10, 12}}; !reflect.DeepEqual(sum.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, sum.Data)
+    }
+
+    product, err := a.Multiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if expected := [][]int{{19, 22}, {43, 50}}; !reflect.DeepEqual(product.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, product.Data)
+    }
+
+    squared, err := a.Map(func(x int) int { return x * x })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if expected := [][]int{{1, 4}, {9, 16}}; !reflect.DeepEqual(squared.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, squared.Data)
+    }
+
+    column, err := NewGenericMatrix(2, 1, [][]int{{1}, {2}})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if _, err := a.Add(column); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+    if _, err := column.Multiply(a); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+
+    if _, err := NewGenericMatrix(2, 2, [][]int{{1, 2}}); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+func TestGenericMatrixFloat32(t *testing.T) {
+    a, err := NewGenericMatrix(1, 2, [][]float32{
+        {0.5, 1.5},
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    b, err := NewGenericMatrix(2, 1, [][]float32{
+        {2},
+        {4},
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    product, err := a.Multiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if expected := [][]float32{{7}}; !reflect.DeepEqual(product.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, product.Data)
+    }
+
+    // Round trip through the float64 Matrix
+    converted := a.ToMatrix()
+    if expected := [][]float64{{0.5, 1.5}}; !reflect.DeepEqual(converted.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, converted.Data)
+    }
+    back := NewGenericFromMatrix[float32](converted)
+    if !reflect.DeepEqual(back.Data, a.Data) {
+        t.Fatalf("expected %v, got %v", a.Data, back.Data)
+    }
+}
+
+func TestFloat64Matrix(t *testing.T) {
+    m := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+
+    var f Float64Matrix = NewGenericFromMatrix[float64](m)
+    doubled, err := f.Map(func(x float64) float64 { return x * 2 })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected, err := m.Map(func(x float64) float64 { return x * 2 })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !sameMatrix(doubled.ToMatrix(), expected) {
+        t.Fatalf("expected %v, got %v", expected.Data, doubled.Data)
+    }
+}
@@ -0,0 +1,87 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+)
+
+// ParallelepipedVolume returns the k-dimensional volume of the parallelepiped spanned by the rows of vectors.
+// For square input this is the absolute value of the determinant. Otherwise the k rows are edges in
+// n-dimensional space and the volume is the square root of the Gram determinant det(GGᵀ).
+// Linearly dependent edges, including more edges than dimensions, give a volume of zero.
+func ParallelepipedVolume(vectors Matrix) (float64, error) {
+    if vectors.Rows == vectors.Cols {
+        det, err := vectors.Determinant()
+        if err != nil {
+            return 0, err
+        }
+        return math.Abs(det), nil
+    }
+
+    gram, err := vectors.Multiply(vectors.T())
+    if err != nil {
+        return 0, err
+    }
+    det, err := gram.Determinant()
+    if err != nil {
+        return 0, err
+    }
+
+    // Rounding can push the determinant of a singular Gram matrix slightly below zero
+    if det < 0 {
+        return 0, nil
+    }
+    return math.Sqrt(det), nil
+}
+
+// PointsAffinelyDependent reports whether the rows of points, taken as points in Cols-dimensional space,
+// lie in an affine subspace of lower dimension, e.g. collinear points in the plane or coplanar points in space.
+// The points are centered on their mean and the rank of the result, with entries within tol of zero
+// treated as zero, is compared against the dimension. Fewer than Cols+1 points always lie in such a subspace.
+func PointsAffinelyDependent(points Matrix, tol float64) bool {
+    mean, err := points.MeanAxis(0)
+    if err != nil {
+        panic(err)
+    }
+
+    centered := points.clone()
+    for i := range centered.Data {
+        for j := range centered.Data[i] {
+            centered.Data[i][j] -= mean.Data[0][j]
+        }
+    }
+
+    _, rank := centered.rrefPivots(tol)
+    return rank < points.Cols
+}
+
+// SimplexVolume returns the n-dimensional volume of the simplex whose n+1 vertices are the rows of vertices.
+// The edges from the first vertex to the others span a parallelepiped whose volume, |det(edges)|,
+// is n! times that of the simplex. Degenerate simplices have a volume of zero.
+// Returns an error unless there are exactly Cols+1 vertices.
+func SimplexVolume(vertices Matrix) (float64, error) {
+    n := vertices.Cols
+    if vertices.Rows != n+1 {
+        return 0, fmt.Errorf("%w: a simplex in %d dimensions needs %d vertices, got %d",
+            ErrDimensionMismatch, n, n+1, vertices.Rows)
+    }
+
+    edges, err := NewZeroMatrix(n, n)
+    if err != nil {
+        panic(err)
+    }
+    for i := range edges.Data {
+        for j := range edges.Data[i] {
+            edges.Data[i][j] = vertices.Data[i+1][j] - vertices.Data[0][j]
+        }
+    }
+
+    volume, err := ParallelepipedVolume(edges)
+    if err != nil {
+        return 0, err
+    }
+    for k := 2; k <= n; k++ {
+        volume /= float64(k)
+    }
+    return volume, nil
+}
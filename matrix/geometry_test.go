@@ -0,0 +1,144 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+func TestParallelepipedVolume(t *testing.T) {
+    cube, err := NewIdentityMatrix(3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    volume, err := ParallelepipedVolume(cube)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(volume-1) > 1e-9 {
+        t.Fatalf("expected volume 1, got %f", volume)
+    }
+
+    // A 3x4 rectangle in the xy-plane of 3-space has area 12
+    rectangle := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {3, 0, 0},
+            {0, 4, 0},
+        },
+    }
+
+    volume, err = ParallelepipedVolume(rectangle)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(volume-12) > 1e-9 {
+        t.Fatalf("expected area 12, got %f", volume)
+    }
+
+    flat := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 0, 0},
+            {0, 1, 0},
+            {1, 1, 0},
+        },
+    }
+
+    volume, err = ParallelepipedVolume(flat)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(volume) > 1e-9 {
+        t.Fatalf("expected volume 0, got %f", volume)
+    }
+}
+
+func TestPointsAffinelyDependent(t *testing.T) {
+    collinear := Matrix{
+        Rows: 3,
+        Cols: 2,
+        Data: [][]float64{
+            {0, 1},
+            {1, 3},
+            {2.5, 6},
+        },
+    }
+    if !PointsAffinelyDependent(collinear, 1e-9) {
+        t.Fatal("expected collinear points to be affinely dependent")
+    }
+
+    triangle := Matrix{
+        Rows: 3,
+        Cols: 2,
+        Data: [][]float64{
+            {0, 0},
+            {1, 0},
+            {0, 1},
+        },
+    }
+    if PointsAffinelyDependent(triangle, 1e-9) {
+        t.Fatal("expected triangle vertices not to be affinely dependent")
+    }
+
+    // Four points on the plane z = x + y in 3-space
+    coplanar := Matrix{
+        Rows: 4,
+        Cols: 3,
+        Data: [][]float64{
+            {0, 0, 0},
+            {1, 0, 1},
+            {0, 2, 2},
+            {3, 1, 4},
+        },
+    }
+    if !PointsAffinelyDependent(coplanar, 1e-9) {
+        t.Fatal("expected coplanar points to be affinely dependent")
+    }
+}
+
+func TestSimplexVolume(t *testing.T) {
+    triangle := Matrix{
+        Rows: 3,
+        Cols: 2,
+        Data: [][]float64{
+            {0, 0},
+            {1, 0},
+            {0, 1},
+        },
+    }
+    area, err := SimplexVolume(triangle)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(area-0.5) > 1e-12 {
+        t.Fatalf("expected area 1/2, got %f", area)
+    }
+
+    // Translating the tetrahedron must not change its volume
+    tetrahedron := Matrix{
+        Rows: 4,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 1, 1},
+            {2, 1, 1},
+            {1, 2, 1},
+            {1, 1, 2},
+        },
+    }
+    volume, err := SimplexVolume(tetrahedron)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(volume-1.0/6) > 1e-12 {
+        t.Fatalf("expected volume 1/6, got %f", volume)
+    }
+
+    _, err = SimplexVolume(triangle.block(0, 2, 0, 2))
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
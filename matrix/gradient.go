@@ -0,0 +1,30 @@
+package matrix
+
+// NumericalGradient approximates the gradient of a scalar function of a matrix using central differences.
+// Element (i, j) of the result is (f(M + eps*E_ij) - f(M - eps*E_ij)) / (2*eps).
+// This calls f twice per element, so it costs O(Rows·Cols) evaluations of f and is meant for
+// checking analytic gradients rather than for use in training loops. m is not modified.
+func NumericalGradient(f func(Matrix) float64, m Matrix, eps float64) Matrix {
+    probe := m.clone()
+    grad, err := NewZeroMatrix(m.Rows, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range probe.Data {
+        for j := range probe.Data[i] {
+            original := probe.Data[i][j]
+
+            probe.Data[i][j] = original + eps
+            plus := f(probe)
+            probe.Data[i][j] = original - eps
+            minus := f(probe)
+            probe.Data[i][j] = original
+
+            grad.Data[i][j] = (plus - minus) / (2 * eps)
+        }
+    }
+
+    return grad
+}
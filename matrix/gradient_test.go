@@ -0,0 +1,31 @@
+package matrix
+
+import (
+    "testing"
+)
+
+// TestNumericalGradient tests the finite-difference gradient of sum(M²), which is 2M.
+func TestNumericalGradient(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, -2, 0.5},
+            {3, 0, -4},
+        },
+    }
+    sumOfSquares := func(m Matrix) float64 {
+        return m.FrobeniusNorm() * m.FrobeniusNorm()
+    }
+
+    grad := NumericalGradient(sumOfSquares, a, 1e-5)
+
+    assertClose(t, [][]float64{
+        {2, -4, 1},
+        {6, 0, -8},
+    }, grad)
+
+    if a.Data[0][0] != 1 {
+        t.Fatalf("expected input matrix to be unchanged, got %v", a.Data)
+    }
+}
@@ -0,0 +1,312 @@
+package matrix
+
+import (
+    "math"
+)
+
+// pivotTolerance is the magnitude below which a pivot is treated as zero during elimination.
+const pivotTolerance = 1e-12
+
+// luDecomposition holds the result of an LU factorization with partial pivoting.
+// L and U are packed into a single matrix: U occupies the upper triangle and diagonal,
+// while the strictly lower triangle holds the multipliers of L (whose diagonal is all ones).
+// perm[i] is the row of the original matrix that ended up in row i, and swaps is the
+// number of row exchanges performed while pivoting.
+type luDecomposition struct {
+    lu       [][]float64
+    perm     []int
+    swaps    int
+    singular bool
+}
+
+// sign returns the determinant of the row permutation: +1 for an even number of swaps, -1 for odd.
+func (d luDecomposition) sign() float64 {
+    if d.swaps%2 == 1 {
+        return -1
+    }
+    return 1
+}
+
+// luDecompose factors a square matrix into PA = LU using partial pivoting.
+// A singular matrix is still factored, but the result is flagged as singular.
+func luDecompose(m Matrix) luDecomposition {
+    return luDecomposeThreshold(m, 1)
+}
+
+// luDecomposeThreshold factors a square matrix into PA = LU using threshold partial pivoting.
+// The current diagonal entry is kept as the pivot whenever its magnitude is at least threshold times
+// the largest magnitude below it in the column; otherwise the row with the largest magnitude is swapped in.
+// A threshold of 1 is ordinary partial pivoting.
+func luDecomposeThreshold(m Matrix, threshold float64) luDecomposition {
+    n := m.Rows
+    lu := make([][]float64, n)
+    perm := make([]int, n)
+    for i := range m.Data {
+        lu[i] = make([]float64, n)
+        copy(lu[i], m.Data[i])
+        perm[i] = i
+    }
+
+    swaps := 0
+    singular := false
+
+    for k := 0; k < n; k++ {
+        // Find the row with the largest magnitude in column k, keeping the diagonal if it is large enough
+        pivot := k
+        for i := k + 1; i < n; i++ {
+            if math.Abs(lu[i][k]) > math.Abs(lu[pivot][k]) {
+                pivot = i
+            }
+        }
+        if diagonal := math.Abs(lu[k][k]); diagonal >= pivotTolerance && diagonal >= threshold*math.Abs(lu[pivot][k]) {
+            pivot = k
+        }
+        if pivot != k {
+            lu[k], lu[pivot] = lu[pivot], lu[k]
+            perm[k], perm[pivot] = perm[pivot], perm[k]
+            swaps++
+        }
+
+        if math.Abs(lu[k][k]) < pivotTolerance {
+            singular = true
+            continue
+        }
+
+        for i := k + 1; i < n; i++ {
+            lu[i][k] /= lu[k][k]
+            for j := k + 1; j < n; j++ {
+                lu[i][j] -= lu[i][k] * lu[k][j]
+            }
+        }
+    }
+
+    return luDecomposition{lu: lu, perm: perm, swaps: swaps, singular: singular}
+}
+
+// solveColumn solves Ax = b for a single right-hand side using the factorization.
+func (d luDecomposition) solveColumn(b []float64) []float64 {
+    n := len(d.lu)
+    x := make([]float64, n)
+
+    // Forward substitution with the unit lower triangle
+    for i := 0; i < n; i++ {
+        x[i] = b[d.perm[i]]
+        for j := 0; j < i; j++ {
+            x[i] -= d.lu[i][j] * x[j]
+        }
+    }
+
+    // Back substitution with the upper triangle
+    for i := n - 1; i >= 0; i-- {
+        for j := i + 1; j < n; j++ {
+            x[i] -= d.lu[i][j] * x[j]
+        }
+        x[i] /= d.lu[i][i]
+    }
+
+    return x
+}
+
+// Determinant returns the determinant of a square matrix.
+// Triangular matrices use the product of the diagonal; all others are
+// computed from an LU factorization with partial pivoting.
+// Returns an error if the matrix is not square.
+func (m Matrix) Determinant() (float64, error) {
+    if m.Rows != m.Cols {
+        return 0, notSquare("Determinant", m)
+    }
+    if det, ok := m.cached(memoDeterminant); ok {
+        return det.(float64), nil
+    }
+
+    if upper, lower := m.IsTriangular(); upper || lower {
+        det := m.triangularDeterminant()
+        m.store(memoDeterminant, det)
+        return det, nil
+    }
+
+    d := luDecompose(m)
+    det := d.sign()
+    for i := range d.lu {
+        det *= d.lu[i][i]
+    }
+
+    m.store(memoDeterminant, det)
+    return det, nil
+}
+
+// cofactorMaxSize is the largest matrix DeterminantVerified cross-checks by cofactor expansion,
+// whose cost grows factorially with the size.
+const cofactorMaxSize = 8
+
+// cofactorDeterminant expands the determinant along the first remaining row, recursing on the minors.
+// cols lists the columns of the minor, which uses the last len(cols) rows of data.
+func cofactorDeterminant(data [][]float64, cols []int) float64 {
+    row := len(data) - len(cols)
+    if len(cols) == 1 {
+        return data[row][cols[0]]
+    }
+
+    det := 0.0
+    sign := 1.0
+    minor := make([]int, len(cols)-1)
+    for k, col := range cols {
+        copy(minor, cols[:k])
+        copy(minor[k:], cols[k+1:])
+        if data[row][col] != 0 {
+            det += sign * data[row][col] * cofactorDeterminant(data, minor)
+        }
+        sign = -sign
+    }
+    return det
+}
+
+// DeterminantVerified returns the determinant from an LU factorization together with whether an
+// independent cofactor expansion agrees with it to within an absolute difference of tol.
+// Matrices larger than 8×8 are not cross-checked, since cofactor expansion costs O(n!); for those
+// the LU determinant is returned and verified is false.
+// Returns an error if the matrix is not square.
+func (m Matrix) DeterminantVerified(tol float64) (det float64, verified bool, err error) {
+    if m.Rows != m.Cols {
+        return 0, false, notSquare("DeterminantVerified", m)
+    }
+
+    d := luDecompose(m)
+    det = d.sign()
+    for i := range d.lu {
+        det *= d.lu[i][i]
+    }
+    if m.Rows > cofactorMaxSize {
+        return det, false, nil
+    }
+
+    cols := make([]int, m.Cols)
+    for j := range cols {
+        cols[j] = j
+    }
+    expanded := cofactorDeterminant(m.Data, cols)
+
+    return det, math.Abs(det-expanded) <= tol, nil
+}
+
+// Inverse returns the inverse of a square matrix.
+// Triangular matrices are inverted directly by substitution; all others are
+// computed from an LU factorization with partial pivoting.
+// Returns an error if the matrix is not square or is singular.
+func (m Matrix) Inverse() (Matrix, error) {
+    if m.Rows != m.Cols {
+        return Matrix{}, notSquare("Inverse", m)
+    }
+    if inverse, ok := m.cached(memoInverse); ok {
+        return inverse.(Matrix).clone(), nil
+    }
+
+    if upper, lower := m.IsTriangular(); upper || lower {
+        inverse, err := m.triangularInverse(upper)
+        if err != nil {
+            return Matrix{}, err
+        }
+        m.store(memoInverse, inverse.clone())
+        return inverse, nil
+    }
+
+    d := luDecompose(m)
+    if d.singular {
+        return Matrix{}, ErrSingular
+    }
+
+    inverse, err := NewZeroMatrix(m.Rows, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    unit := make([]float64, m.Rows)
+    for j := 0; j < m.Cols; j++ {
+        unit[j] = 1
+        column := d.solveColumn(unit)
+        unit[j] = 0
+        for i := range column {
+            inverse.Data[i][j] = column[i]
+        }
+    }
+
+    m.store(memoInverse, inverse.clone())
+    return inverse, nil
+}
+
+// PivotSign returns the number of row swaps performed by partial pivoting during LU factorization
+// and the resulting sign of the row permutation (+1 or -1).
+// The sign is the factor the product of U's diagonal must be multiplied by to give the determinant.
+// Returns an error if the matrix is not square.
+func (m Matrix) PivotSign() (int, int, error) {
+    if m.Rows != m.Cols {
+        return 0, 0, notSquare("PivotSign", m)
+    }
+
+    d := luDecompose(m)
+    return d.swaps, int(d.sign()), nil
+}
+
+// InverseEquilibrated returns the inverse of a square matrix, equilibrating it first for accuracy.
+// Rows and then columns are scaled to unit Euclidean norm before factorization, which keeps
+// partial pivoting effective when entries differ by many orders of magnitude. The scaling is undone
+// afterwards, so the result is the inverse of the original matrix, not of the scaled one.
+// Returns an error if the matrix is not square or is singular.
+func (m Matrix) InverseEquilibrated() (Matrix, error) {
+    if m.Rows != m.Cols {
+        return Matrix{}, notSquare("InverseEquilibrated", m)
+    }
+
+    n := m.Rows
+    scaled := m.clone()
+
+    rowScale := make([]float64, n)
+    for i, row := range scaled.Data {
+        norm := math.Sqrt(sumOfSquares(row))
+        if norm == 0 {
+            return Matrix{}, ErrSingular
+        }
+        rowScale[i] = 1 / norm
+        for j := range row {
+            row[j] *= rowScale[i]
+        }
+    }
+
+    colScale := make([]float64, n)
+    for j := 0; j < n; j++ {
+        norm := 0.0
+        for i := range scaled.Data {
+            norm += scaled.Data[i][j] * scaled.Data[i][j]
+        }
+        if norm == 0 {
+            return Matrix{}, ErrSingular
+        }
+        colScale[j] = 1 / math.Sqrt(norm)
+        for i := range scaled.Data {
+            scaled.Data[i][j] *= colScale[j]
+        }
+    }
+
+    // With B = RAC, the original inverse is C * inv(B) * R
+    inverse, err := scaled.Inverse()
+    if err != nil {
+        return Matrix{}, err
+    }
+    for i := range inverse.Data {
+        for j := range inverse.Data[i] {
+            inverse.Data[i][j] *= colScale[i] * rowScale[j]
+        }
+    }
+
+    return inverse, nil
+}
+
+// sumOfSquares returns the sum of the squares of values.
+func sumOfSquares(values []float64) float64 {
+    total := 0.0
+    for _, v := range values {
+        total += v * v
+    }
+    return total
+}
@@ -0,0 +1,280 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+// TestDeterminant tests the determinant of a known matrix and of a singular matrix.
+func TestDeterminant(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, -3, 1},
+            {2, 0, -1},
+            {1, 4, 5},
+        },
+    }
+
+    det, err := a.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(det-49) > 1e-9 {
+        t.Fatalf("expected determinant 49, got %f", det)
+    }
+
+    singular := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {2, 4},
+        },
+    }
+
+    det, err = singular.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(det) > 1e-9 {
+        t.Fatalf("expected determinant 0, got %f", det)
+    }
+
+    c := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    _, err = c.Determinant()
+    if err == nil {
+        t.Fatal("expected error for non-square matrix, but got none")
+    }
+}
+
+// TestInverse tests that a matrix multiplied by its inverse gives the identity.
+func TestInverse(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {0, 2, 1},
+            {1, 1, 0},
+            {3, 0, 4},
+        },
+    }
+
+    inverse, err := a.Inverse()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    product, err := a.Multiply(inverse)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    for i := range product.Data {
+        for j := range product.Data[i] {
+            expected := 0.0
+            if i == j {
+                expected = 1
+            }
+            if math.Abs(product.Data[i][j]-expected) > 1e-9 {
+                t.Fatalf("expected identity, got %v", product.Data)
+            }
+        }
+    }
+
+    singular := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {2, 4},
+        },
+    }
+
+    _, err = singular.Inverse()
+    if err == nil {
+        t.Fatal("expected error for singular matrix, but got none")
+    }
+}
+
+// TestPivotSign tests the swap count and sign for matrices needing a known number of pivots.
+func TestPivotSign(t *testing.T) {
+    // Each column's largest entry is in the last remaining row, so every step swaps
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 0, 0},
+            {2, 1, 0},
+            {4, 3, 1},
+        },
+    }
+
+    swaps, sign, err := a.PivotSign()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if swaps != 2 || sign != 1 {
+        t.Fatalf("expected 2 swaps with sign 1, got %d swaps with sign %d", swaps, sign)
+    }
+
+    b := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {0, 1},
+            {1, 0},
+        },
+    }
+
+    swaps, sign, err = b.PivotSign()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if swaps != 1 || sign != -1 {
+        t.Fatalf("expected 1 swap with sign -1, got %d swaps with sign %d", swaps, sign)
+    }
+
+    c := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    _, _, err = c.PivotSign()
+    if err == nil {
+        t.Fatal("expected error for non-square matrix, but got none")
+    }
+}
+
+// TestInverseEquilibrated tests that equilibration recovers an accurate inverse of a badly-scaled matrix.
+func TestInverseEquilibrated(t *testing.T) {
+    scale := 1e12
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {2, 2 * scale},
+            {1, 1e-3},
+        },
+    }
+
+    det := 2*1e-3 - 2*scale
+    exact := [][]float64{
+        {1e-3 / det, -2 * scale / det},
+        {-1 / det, 2 / det},
+    }
+
+    // maxRelativeError returns the largest element-wise relative error against the exact inverse
+    maxRelativeError := func(inverse Matrix) float64 {
+        worst := 0.0
+        for i := range exact {
+            for j := range exact[i] {
+                worst = math.Max(worst, math.Abs(inverse.Data[i][j]-exact[i][j])/math.Abs(exact[i][j]))
+            }
+        }
+        return worst
+    }
+
+    plain, err := a.Inverse()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    equilibrated, err := a.InverseEquilibrated()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    plainError := maxRelativeError(plain)
+    equilibratedError := maxRelativeError(equilibrated)
+    if equilibratedError >= plainError {
+        t.Fatalf("expected equilibrated error %g to be below plain error %g", equilibratedError, plainError)
+    }
+    if equilibratedError > 1e-12 {
+        t.Fatalf("expected equilibrated inverse to be accurate, got relative error %g", equilibratedError)
+    }
+
+    singular := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {0, 0},
+        },
+    }
+
+    _, err = singular.InverseEquilibrated()
+    if !errors.Is(err, ErrSingular) {
+        t.Fatalf("expected ErrSingular, got %v", err)
+    }
+}
+
+func TestDeterminantVerified(t *testing.T) {
+    a := Matrix{
+        Rows: 4,
+        Cols: 4,
+        Data: [][]float64{
+            {2, -1, 0, 3},
+            {1, 4, 2, 0},
+            {0, 3, 5, -2},
+            {1, 0, 1, 6},
+        },
+    }
+
+    det, verified, err := a.DeterminantVerified(1e-9)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !verified {
+        t.Fatal("expected LU and cofactor expansion to agree")
+    }
+    expected, err := a.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(det-expected) > 1e-9 {
+        t.Fatalf("expected determinant %f, got %f", expected, det)
+    }
+
+    // The exact determinant is 1, but (1e8+1)(1e8-1) rounds to 1e16, so the cofactor expansion gives 0
+    pathological := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1e8, 1e8 + 1},
+            {1e8 - 1, 1e8},
+        },
+    }
+    _, verified, err = pathological.DeterminantVerified(0.5)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if verified {
+        t.Fatal("expected the algorithms to disagree on an ill-conditioned matrix")
+    }
+
+    large, err := NewIdentityMatrix(cofactorMaxSize + 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    det, verified, err = large.DeterminantVerified(1e-9)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if verified || det != 1 {
+        t.Fatalf("expected unverified determinant 1 above the size limit, got %f verified=%v", det, verified)
+    }
+}
@@ -0,0 +1,68 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+)
+
+// stochasticTolerance is how far a row sum may be from 1 for the row to count as stochastic.
+const stochasticTolerance = 1e-9
+
+// validateRowStochastic returns an error unless the matrix is square with non-negative rows summing to 1.
+func (m Matrix) validateRowStochastic(op string) error {
+    if m.Rows != m.Cols {
+        return notSquare(op, m)
+    }
+    for i, row := range m.Data {
+        total := 0.0
+        for j, val := range row {
+            if val < 0 {
+                return fmt.Errorf("%w: %s requires non-negative entries, but entry (%d, %d) is negative", ErrNotStochastic, op, i, j)
+            }
+            total += val
+        }
+        if math.Abs(total-1) > stochasticTolerance {
+            return fmt.Errorf("%w: %s requires rows summing to 1, but row %d sums to %g", ErrNotStochastic, op, i, total)
+        }
+    }
+    return nil
+}
+
+// rowsAgree reports whether every column's entries differ by at most tol across all rows.
+func (m Matrix) rowsAgree(tol float64) bool {
+    for j := 0; j < m.Cols; j++ {
+        low, high := m.Data[0][j], m.Data[0][j]
+        for i := 1; i < m.Rows; i++ {
+            low = math.Min(low, m.Data[i][j])
+            high = math.Max(high, m.Data[i][j])
+        }
+        if high-low > tol {
+            return false
+        }
+    }
+    return true
+}
+
+// MixesWithin estimates the mixing time of a Markov chain with this row-stochastic transition matrix.
+// It returns the smallest number of steps k for which every row of P^k agrees within tol,
+// meaning the distribution after k steps no longer depends on the starting state.
+// Returns an error if the matrix is not row-stochastic or the rows do not agree within maxSteps steps.
+func (m Matrix) MixesWithin(tol float64, maxSteps int) (int, error) {
+    if err := m.validateRowStochastic("MixesWithin"); err != nil {
+        return 0, err
+    }
+
+    power := m
+    for step := 1; step <= maxSteps; step++ {
+        if power.rowsAgree(tol) {
+            return step, nil
+        }
+        next, err := power.Multiply(m)
+        if err != nil {
+            panic(err)
+        }
+        power = next
+    }
+
+    return 0, fmt.Errorf("%w: chain did not mix within %d steps", ErrNoConvergence, maxSteps)
+}
@@ -0,0 +1,94 @@
+package matrix
+
+import (
+    "errors"
+    "testing"
+)
+
+func TestMixesWithin(t *testing.T) {
+    independent := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {0.5, 0.5},
+            {0.5, 0.5},
+        },
+    }
+
+    steps, err := independent.MixesWithin(1e-6, 10)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if steps != 1 {
+        t.Fatalf("expected 1 step, got %d", steps)
+    }
+
+    // Rows differ by 0.4^k after k steps
+    fast := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {0.9, 0.1},
+            {0.5, 0.5},
+        },
+    }
+
+    steps, err = fast.MixesWithin(1e-6, 100)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if steps != 16 {
+        t.Fatalf("expected 16 steps, got %d", steps)
+    }
+
+    // Rows differ by 0.98^k after k steps
+    slow := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {0.99, 0.01},
+            {0.01, 0.99},
+        },
+    }
+
+    _, err = slow.MixesWithin(1e-6, 100)
+    if err == nil {
+        t.Fatal("expected error for chain that does not mix within the step limit, but got none")
+    }
+
+    steps, err = slow.MixesWithin(1e-6, 1000)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if steps <= 100 {
+        t.Fatalf("expected slow chain to need more than 100 steps, got %d", steps)
+    }
+
+    notStochastic := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {0.9, 0.2},
+            {0.5, 0.5},
+        },
+    }
+
+    _, err = notStochastic.MixesWithin(1e-6, 100)
+    if !errors.Is(err, ErrNotStochastic) {
+        t.Fatalf("expected ErrNotStochastic for rows not summing to 1, got %v", err)
+    }
+
+    negative := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1.2, -0.2},
+            {0.5, 0.5},
+        },
+    }
+
+    _, err = negative.MixesWithin(1e-6, 100)
+    if !errors.Is(err, ErrNotStochastic) {
+        t.Fatalf("expected ErrNotStochastic for a negative entry, got %v", err)
+    }
+}
@@ -1,8 +1,8 @@
 package matrix
 
 import (
-    "errors"
     "fmt"
+    "math"
     "math/rand"
     "time"
 )
@@ -12,29 +12,37 @@ type Matrix struct {
     Rows int
     Cols int
     Data [][]float64
+
+    // backing is the flat array holding every row when the matrix was allocated by newData
+    backing []float64
+
+    // memo caches derived results once enabled with EnableCache
+    memo *memo
+
+    // frozen disables the in-place mutators for matrices returned by Builder.Build
+    frozen bool
 }
 
 // Creates a new Matrix
 // Returns an error if dimensions are not greater than 0 or data shape is mismatched
 func NewMatrix(rows, cols int, data[][]float64) (Matrix, error) {
     if rows <= 0 || cols <= 0 {
-        return Matrix{}, errors.New("dimensions must be positive integers")
+        return Matrix{}, ErrInvalidDimensions
     }
     if len(data) != rows {
-        return Matrix{}, errors.New("invalid data dimension: rows")
+        return Matrix{}, fmt.Errorf("%w: expected %d rows of data, got %d", ErrDimensionMismatch, rows, len(data))
     }
-    if len(data[0]) != cols {
-        return Matrix{}, errors.New("invalid data dimension: columns")
+    for _, row := range data {
+        if len(row) != cols {
+            return Matrix{}, fmt.Errorf("%w: expected %d columns of data, got %d", ErrDimensionMismatch, cols, len(row))
+        }
     }
     return Matrix{Rows: rows, Cols: cols, Data: data}, nil
 }
 
 // Creates a new Matrix initialized with zeroes
 func NewZeroMatrix(rows, cols int) (Matrix, error) {
-    data := make([][]float64, rows)
-    for i := range data {
-        data[i] = make([]float64, cols)
-    }
+    data, backing := newData[float64](rows, cols)
 
     result, err := NewMatrix(rows, cols, data)
 
@@ -42,13 +50,14 @@ func NewZeroMatrix(rows, cols int) (Matrix, error) {
         panic(err)
     }
 
+    result.backing = backing
     return result, nil
 }
 
 // Creates a new identify Matrix of a given size
 func NewIdentityMatrix(size int) (Matrix, error) {
     if size <= 0 {
-        return Matrix{}, errors.New("Expected an identity matrix size greater than 0")
+        return Matrix{}, ErrInvalidDimensions
     }
 
     identity, err := NewZeroMatrix(size, size)
@@ -64,14 +73,91 @@ func NewIdentityMatrix(size int) (Matrix, error) {
     return identity, nil
 }
 
+// NewConstantMatrix creates a new Matrix with every entry set to value
+func NewConstantMatrix(rows, cols int, value float64) (Matrix, error) {
+    if rows <= 0 || cols <= 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+
+    result, err := NewZeroMatrix(rows, cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for _, row := range result.Data {
+        for j := range row {
+            row[j] = value
+        }
+    }
+
+    return result, nil
+}
+
+// NewMatrixFromFunc creates a new Matrix whose element (i, j) is f(i, j)
+// Returns an error if dimensions are not greater than 0
+func NewMatrixFromFunc(rows, cols int, f func(i, j int) float64) (Matrix, error) {
+    if rows <= 0 || cols <= 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+
+    result, err := NewZeroMatrix(rows, cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range result.Data {
+        for j := range result.Data[i] {
+            result.Data[i][j] = f(i, j)
+        }
+    }
+
+    return result, nil
+}
+
+// NewDiagonalMatrix creates a square Matrix with the given values on the main diagonal and zeros elsewhere
+func NewDiagonalMatrix(values []float64) Matrix {
+    data := make([][]float64, len(values))
+    for i := range data {
+        data[i] = make([]float64, len(values))
+        data[i][i] = values[i]
+    }
+    return Matrix{Rows: len(values), Cols: len(values), Data: data}
+}
+
+// Diagonal returns the entries of the main diagonal.
+// For rectangular matrices only the first min(Rows, Cols) diagonal entries exist.
+func (m Matrix) Diagonal() []float64 {
+    n := m.Rows
+    if m.Cols < n {
+        n = m.Cols
+    }
+
+    result := make([]float64, n)
+    for i := range result {
+        result[i] = m.Data[i][i]
+    }
+    return result
+}
+
+// clone returns a deep copy of the matrix data without any cached results.
+func (m Matrix) clone() Matrix {
+    data, backing := newData[float64](m.Rows, m.Cols)
+    for i := range m.Data {
+        copy(data[i], m.Data[i])
+    }
+    return Matrix{Rows: m.Rows, Cols: m.Cols, Data: data, backing: backing}
+}
+
 // Adds to matrices together
 func (m Matrix) Add(other Matrix) (Matrix, error) {
     if m.Rows != other.Rows || m.Cols != other.Cols {
-        return Matrix{}, errors.New("matrices must have matching dimensions")
+        return Matrix{}, elementwiseMismatch("Add", m, other)
     }
     result := make([][]float64, m.Rows)
     for i:= range m.Data {
-        result[i] = make([]float64, m.Rows)
+        result[i] = make([]float64, m.Cols)
         for j := range m.Data[i] {
             result[i][j] = m.Data[i][j] + other.Data[i][j]
         }
@@ -83,11 +169,75 @@ func (m Matrix) Add(other Matrix) (Matrix, error) {
     }, nil
 }
 
+// GradientStep returns m - learningRate*grad, the update applied by first-order optimizers.
+// Returns an error if the gradient does not have the same dimensions as the matrix.
+func (m Matrix) GradientStep(grad Matrix, learningRate float64) (Matrix, error) {
+    if m.Rows != grad.Rows || m.Cols != grad.Cols {
+        return Matrix{}, elementwiseMismatch("GradientStep", m, grad)
+    }
+
+    result, err := NewZeroMatrix(m.Rows, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range m.Data {
+        for j := range m.Data[0] {
+            result.Data[i][j] = m.Data[i][j] - learningRate*grad.Data[i][j]
+        }
+    }
+
+    return result, nil
+}
+
+// AXPY returns alpha*m + other, computed element-wise in a single pass without an intermediate scaled matrix.
+// Returns an error if other does not have the same dimensions as the matrix.
+func (m Matrix) AXPY(alpha float64, other Matrix) (Matrix, error) {
+    if m.Rows != other.Rows || m.Cols != other.Cols {
+        return Matrix{}, elementwiseMismatch("AXPY", m, other)
+    }
+
+    result, err := NewZeroMatrix(m.Rows, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range m.Data {
+        for j := range m.Data[0] {
+            result.Data[i][j] = alpha*m.Data[i][j] + other.Data[i][j]
+        }
+    }
+
+    return result, nil
+}
+
+// AXPYInPlace overwrites the receiver with alpha*m + other without allocating.
+// Returns an error if other does not have the same dimensions as the matrix or the matrix is immutable.
+func (m *Matrix) AXPYInPlace(alpha float64, other Matrix) error {
+    if m.Rows != other.Rows || m.Cols != other.Cols {
+        return elementwiseMismatch("AXPYInPlace", *m, other)
+    }
+    if err := m.touch(); err != nil {
+        return err
+    }
+
+    for i := range m.Data {
+        for j := range m.Data[0] {
+            m.Data[i][j] = alpha*m.Data[i][j] + other.Data[i][j]
+        }
+    }
+
+    return nil
+}
+
 // Multiple performs matrix multiplication between two matrices.
 // Returns and error if matrices have incompatible dimensions.
 func (m Matrix) Multiply(other Matrix) (Matrix, error) {
     if m.Cols != other.Rows {
-        return Matrix{}, errors.New("incompatible dimensions for matrix multiplication")
+        return Matrix{}, fmt.Errorf("%w: cannot multiply %s by %s: inner dimensions %d and %d differ",
+            ErrDimensionMismatch, shape(m), shape(other), m.Cols, other.Rows)
     }
 
     result, err := NewZeroMatrix(m.Rows, other.Cols)
@@ -96,12 +246,10 @@ func (m Matrix) Multiply(other Matrix) (Matrix, error) {
         panic(err)
     }
 
-    for i := range m.Data {
-        for j := range other.Data[0] {
-            for k := range m.Data[0] {
-                result.Data[i][j] += m.Data[i][k] * other.Data[k][j]
-            }
-        }
+    if m.Rows >= BlockedMultiplyThreshold || m.Cols >= BlockedMultiplyThreshold || other.Cols >= BlockedMultiplyThreshold {
+        multiplyBlocked(result.Data, m.Data, other.Data, MultiplyBlockSize)
+    } else {
+        multiplyNaive(result.Data, m.Data, other.Data)
     }
 
     return result, nil
@@ -126,6 +274,7 @@ func (m Matrix) T() (Matrix) {
 
 // Apply a function to all the elements in a matrix.
 // The given function must take and return a float64
+// Use MapErr when the function can fail.
 func (m Matrix) Map(f func(float64) float64) (Matrix, error) {
     result, err := NewZeroMatrix(m.Rows, m.Cols)
 
@@ -135,7 +284,6 @@ func (m Matrix) Map(f func(float64) float64) (Matrix, error) {
 
     for i := range m.Data {
         for j := range m.Data[0] {
-            // TODO: add error handling here
             result.Data[i][j] = f(m.Data[i][j])
         }
     }
@@ -143,22 +291,95 @@ func (m Matrix) Map(f func(float64) float64) (Matrix, error) {
     return result, nil
 }
 
+// MapErr applies a fallible function to all the elements in a matrix.
+// Stops at the first error returned by f and reports the position where it occurred.
+// The original error is wrapped so it can be inspected with errors.Is and errors.As.
+func (m Matrix) MapErr(f func(float64) (float64, error)) (Matrix, error) {
+    result, err := NewZeroMatrix(m.Rows, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range m.Data {
+        for j := range m.Data[0] {
+            val, err := f(m.Data[i][j])
+            if err != nil {
+                return Matrix{}, fmt.Errorf("map failed at position (%d, %d): %w", i, j, err)
+            }
+            result.Data[i][j] = val
+        }
+    }
+
+    return result, nil
+}
+
+// ApplyInPlace applies a function to all the elements in a matrix, mutating the receiver.
+// Unlike Map, no new matrix is allocated, which makes it suitable for tight loops.
+// Panics if the matrix is immutable.
+func (m *Matrix) ApplyInPlace(f func(float64) float64) {
+    if err := m.touch(); err != nil {
+        panic(err)
+    }
+    for i := range m.Data {
+        for j := range m.Data[i] {
+            m.Data[i][j] = f(m.Data[i][j])
+        }
+    }
+}
+
+// Fill sets every element to value, mutating the receiver.
+// The existing rows are reused, so buffers can be reset across iterations without reallocating.
+// Panics if the matrix is immutable.
+func (m *Matrix) Fill(value float64) {
+    if err := m.touch(); err != nil {
+        panic(err)
+    }
+    for i := range m.Data {
+        for j := range m.Data[i] {
+            m.Data[i][j] = value
+        }
+    }
+}
+
+// CopyFrom copies the elements of src into the receiver's existing rows, so a preallocated
+// destination can be reused in a loop without allocating.
+// Returns an error if the shapes differ or the receiver is immutable.
+func (m *Matrix) CopyFrom(src Matrix) error {
+    if m.Rows != src.Rows || m.Cols != src.Cols {
+        return elementwiseMismatch("CopyFrom", *m, src)
+    }
+    if err := m.touch(); err != nil {
+        return err
+    }
+
+    for i := range m.Data {
+        copy(m.Data[i], src.Data[i])
+    }
+
+    return nil
+}
+
 // NewRandomMatrix creates a new matrix with random values between min and max.
 func NewRandomMatrix(rows, cols int, min, max float64) (Matrix, error) {
     if rows <= 0 || cols <= 0 {
-        return Matrix{}, errors.New("number of rows and columns must be greater than 0")
+        return Matrix{}, ErrInvalidDimensions
     }
 
     rand.Seed(time.Now().UnixNano())
-    data := make([][]float64, rows)
-    for i := range data {
-        data[i] = make([]float64, cols)
-        for j := range data[i] {
-            data[i][j] = min + rand.Float64()*(max-min)
+    result, err := NewZeroMatrix(rows, cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range result.Data {
+        for j := range result.Data[i] {
+            result.Data[i][j] = min + rand.Float64()*(max-min)
         }
     }
 
-    return Matrix{Rows: rows, Cols: cols, Data: data}, nil
+    return result, nil
 }
 
 // calculateWidth is a helper function to calculate the largest absolute value in each column of a matrix.
@@ -212,3 +433,259 @@ func (m Matrix) Display(precision int) {
     }
 }
 
+
+// Head returns a copy of the first n rows, or of every row if the matrix has fewer than n.
+// Returns an empty Matrix if n is less than 1.
+func (m Matrix) Head(n int) Matrix {
+    if n < 1 {
+        return Matrix{}
+    }
+    return m.block(0, minInt(n, m.Rows), 0, m.Cols)
+}
+
+// Tail returns a copy of the last n rows, or of every row if the matrix has fewer than n.
+// Returns an empty Matrix if n is less than 1.
+func (m Matrix) Tail(n int) Matrix {
+    if n < 1 {
+        return Matrix{}
+    }
+    return m.block(maxInt(m.Rows-n, 0), m.Rows, 0, m.Cols)
+}
+
+// Reshape returns a new matrix with the given dimensions containing the same elements.
+// Elements are read and written in row-major order.
+// Returns an error if the new shape does not hold the same number of elements.
+func (m Matrix) Reshape(rows, cols int) (Matrix, error) {
+    if rows <= 0 || cols <= 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+    if rows*cols != m.Rows*m.Cols {
+        return Matrix{}, fmt.Errorf("%w: cannot reshape %s into %d×%d", ErrDimensionMismatch, shape(m), rows, cols)
+    }
+
+    result, err := NewZeroMatrix(rows, cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for k := 0; k < rows*cols; k++ {
+        result.Data[k/cols][k%cols] = m.Data[k/m.Cols][k%m.Cols]
+    }
+
+    return result, nil
+}
+
+// Trim removes every row and column whose entries are all within tol of zero.
+// The indices of the rows and columns that were kept are returned in their original order,
+// so results can be mapped back to the original index space.
+// Returns an error if every entry is zero, since the result would be empty.
+func (m Matrix) Trim(tol float64) (trimmed Matrix, rowsKept, colsKept []int, err error) {
+    rowNonZero := make([]bool, m.Rows)
+    colNonZero := make([]bool, m.Cols)
+
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            if math.Abs(val) > tol {
+                rowNonZero[i] = true
+                colNonZero[j] = true
+            }
+        }
+    }
+
+    for i, keep := range rowNonZero {
+        if keep {
+            rowsKept = append(rowsKept, i)
+        }
+    }
+    for j, keep := range colNonZero {
+        if keep {
+            colsKept = append(colsKept, j)
+        }
+    }
+
+    if len(rowsKept) == 0 {
+        return Matrix{}, nil, nil, fmt.Errorf("%w: cannot trim a matrix whose entries are all zero", ErrInvalidDimensions)
+    }
+
+    trimmed, err = NewZeroMatrix(len(rowsKept), len(colsKept))
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i, row := range rowsKept {
+        for j, col := range colsKept {
+            trimmed.Data[i][j] = m.Data[row][col]
+        }
+    }
+
+    return trimmed, rowsKept, colsKept, nil
+}
+
+// Flatten returns all elements of the matrix in row-major order.
+// The returned slice is freshly allocated and does not share storage with the matrix.
+func (m Matrix) Flatten() []float64 {
+    result := make([]float64, 0, m.Rows*m.Cols)
+    for _, row := range m.Data {
+        result = append(result, row...)
+    }
+    return result
+}
+
+// Triplets returns the coordinate (COO) representation of the matrix.
+// Every element whose absolute value is greater than tol is reported as a (row, column, value) triplet.
+// Triplets are emitted in row-major order.
+func (m Matrix) Triplets(tol float64) (rows []int, cols []int, vals []float64) {
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            if math.Abs(val) > tol {
+                rows = append(rows, i)
+                cols = append(cols, j)
+                vals = append(vals, val)
+            }
+        }
+    }
+    return rows, cols, vals
+}
+
+// Divide performs element-wise division of two matrices.
+// Returns an error if the matrices have different dimensions or if any divisor element is zero.
+func (m Matrix) Divide(other Matrix) (Matrix, error) {
+    if m.Rows != other.Rows || m.Cols != other.Cols {
+        return Matrix{}, elementwiseMismatch("Divide", m, other)
+    }
+
+    result, err := NewZeroMatrix(m.Rows, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range m.Data {
+        for j := range m.Data[0] {
+            if other.Data[i][j] == 0.0 {
+                return Matrix{}, fmt.Errorf("%w at position (%d, %d)", ErrDivisionByZero, i, j)
+            }
+            result.Data[i][j] = m.Data[i][j] / other.Data[i][j]
+        }
+    }
+
+    return result, nil
+}
+
+// MulVec multiplies the matrix by a column vector given as a plain slice.
+// This avoids wrapping the vector in an n x 1 Matrix as Multiply would require.
+// Returns an error if the length of v does not match the number of columns.
+func (m Matrix) MulVec(v []float64) ([]float64, error) {
+    if len(v) != m.Cols {
+        return nil, fmt.Errorf("%w: cannot multiply %s by vector of length %d", ErrDimensionMismatch, shape(m), len(v))
+    }
+    return matVec(m.Data, v), nil
+}
+
+// VecMul multiplies a row vector given as a plain slice by the matrix, computing vᵀA.
+// Returns an error if the length of v does not match the number of rows.
+func (m Matrix) VecMul(v []float64) ([]float64, error) {
+    if len(v) != m.Rows {
+        return nil, fmt.Errorf("%w: cannot multiply vector of length %d by %s", ErrDimensionMismatch, len(v), shape(m))
+    }
+
+    result := make([]float64, m.Cols)
+    for i, row := range m.Data {
+        for j, val := range row {
+            result[j] += v[i] * val
+        }
+    }
+
+    return result, nil
+}
+
+// Kronecker returns the Kronecker product of two matrices.
+// The result has dimensions (m.Rows*other.Rows) x (m.Cols*other.Cols), where each element
+// of m scales a full copy of other.
+func (m Matrix) Kronecker(other Matrix) Matrix {
+    result, err := NewZeroMatrix(m.Rows*other.Rows, m.Cols*other.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range m.Data {
+        for j := range m.Data[0] {
+            for k := range other.Data {
+                for l := range other.Data[0] {
+                    result.Data[i*other.Rows+k][j*other.Cols+l] = m.Data[i][j] * other.Data[k][l]
+                }
+            }
+        }
+    }
+
+    return result
+}
+
+// Pow raises a square matrix to an integer power using exponentiation by squaring.
+// A power of 0 returns the identity matrix, and negative powers raise the inverse.
+// Returns an error if the matrix is not square, or if n is negative and the matrix is singular.
+func (m Matrix) Pow(n int) (Matrix, error) {
+    if m.Rows != m.Cols {
+        return Matrix{}, notSquare("Pow", m)
+    }
+
+    base := m
+    if n < 0 {
+        inverse, err := m.Inverse()
+        if err != nil {
+            return Matrix{}, err
+        }
+        base = inverse
+        n = -n
+    }
+
+    result, err := NewIdentityMatrix(m.Rows)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for n > 0 {
+        if n%2 == 1 {
+            result, err = result.Multiply(base)
+            if err != nil {
+                panic(err)
+            }
+        }
+        n /= 2
+        if n > 0 {
+            base, err = base.Multiply(base)
+            if err != nil {
+                panic(err)
+            }
+        }
+    }
+
+    return result, nil
+}
+
+// IsSquare reports whether the matrix has the same number of rows and columns.
+func (m Matrix) IsSquare() bool {
+    return m.Rows == m.Cols
+}
+
+// IsSymmetric reports whether the matrix equals its transpose within tol.
+// Non-square matrices are never symmetric.
+func (m Matrix) IsSymmetric(tol float64) bool {
+    if !m.IsSquare() {
+        return false
+    }
+
+    for i := range m.Data {
+        for j := i + 1; j < m.Cols; j++ {
+            if math.Abs(m.Data[i][j]-m.Data[j][i]) > tol {
+                return false
+            }
+        }
+    }
+
+    return true
+}
@@ -1,7 +1,10 @@
 package matrix
 
 import (
+    "errors"
+    "math"
     "reflect"
+    "strings"
     "testing"
 )
 
@@ -18,6 +21,59 @@ func TestNewZeroMatrix(t *testing.T) {
     }
 }
 
+func TestNewConstantMatrix(t *testing.T) {
+    matrix, err := NewConstantMatrix(2, 2, 5.0)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {5, 5},
+            {5, 5},
+        },
+    }
+
+    if !sameMatrix(matrix, expected) {
+        t.Fatalf("expected %v, got %v", expected, matrix)
+    }
+
+    _, err = NewConstantMatrix(0, 2, 5.0)
+    if !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions, got %v", err)
+    }
+}
+
+func TestNewMatrixFromFunc(t *testing.T) {
+    rows, cols := 2, 3
+    matrix, err := NewMatrixFromFunc(rows, cols, func(i, j int) float64 { return float64(i*cols + j) })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {0, 1, 2},
+        {3, 4, 5},
+    }
+    if !reflect.DeepEqual(matrix.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, matrix.Data)
+    }
+
+    // Hilbert matrix
+    hilbert, err := NewMatrixFromFunc(2, 2, func(i, j int) float64 { return 1 / float64(i+j+1) })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, [][]float64{{1, 0.5}, {0.5, 1.0 / 3}}, hilbert)
+
+    _, err = NewMatrixFromFunc(2, 0, func(i, j int) float64 { return 0 })
+    if !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions, got %v", err)
+    }
+}
+
 func TestNewMatrix(t *testing.T) {
     data := [][]float64{
         {1, 2, 3},
@@ -78,6 +134,27 @@ func TestAdd(t *testing.T) {
         t.Fatalf("expected %v, got %v", expected.Data, result.Data)
     }
 
+    // Non-square operands, where each result row must have Cols entries rather than Rows
+    wide := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+    result, err = wide.Add(wide)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expectedWide := [][]float64{
+        {2, 4, 6},
+        {8, 10, 12},
+    }
+    if !reflect.DeepEqual(result.Data, expectedWide) {
+        t.Fatalf("expected %v, got %v", expectedWide, result.Data)
+    }
+
     c := Matrix{
         Rows: 3,
         Cols: 2,
@@ -92,6 +169,12 @@ func TestAdd(t *testing.T) {
     if err == nil {
         t.Fatal("expected error for matrices with different dimensions, but got none")
     }
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+    if !strings.Contains(err.Error(), "2×2") || !strings.Contains(err.Error(), "3×2") {
+        t.Fatalf("expected error to contain both shapes, got %q", err)
+    }
 }
 
 func TestMultiply(t *testing.T) {
@@ -143,6 +226,12 @@ func TestMultiply(t *testing.T) {
     if err == nil {
         t.Fatal("expected error for matrices with incompatible dimensions, but got none")
     }
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+    if !strings.Contains(err.Error(), "2×3") || !strings.Contains(err.Error(), "2×2") {
+        t.Fatalf("expected error to contain both shapes, got %q", err)
+    }
 }
 
 func TestTranspose(t *testing.T) {
@@ -188,7 +277,7 @@ func TestNewIdentityMatrix(t *testing.T) {
         },
     }
 
-    if !reflect.DeepEqual(identity, expected) {
+    if !sameMatrix(identity, expected) {
         t.Fatalf("expected %v, got %v", expected, identity)
     }
 }
@@ -214,7 +303,7 @@ func TestMatrixMultiplyIdentity(t *testing.T) {
         t.Fatalf("unexpected error: %v", err)
     }
 
-    if !reflect.DeepEqual(result, a) {
+    if !sameMatrix(result, a) {
         t.Fatalf("expected %v, got %v", a, result)
     }
 }
@@ -240,3 +329,778 @@ func TestNewRandomMatrix(t *testing.T) {
         }
     }
 }
+
+// TestReshape tests reshaping a matrix while preserving row-major order.
+func TestReshape(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    result, err := a.Reshape(3, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 2},
+        {3, 4},
+        {5, 6},
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    result, err = a.Reshape(1, 6)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected = [][]float64{
+        {1, 2, 3, 4, 5, 6},
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    _, err = a.Reshape(4, 2)
+    if err == nil {
+        t.Fatal("expected error for incompatible shape, but got none")
+    }
+}
+
+// TestTrim tests removing an all-zero row and column.
+func TestTrim(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 0, 2},
+            {0, 0, 1e-12},
+            {3, 0, 4},
+        },
+    }
+
+    result, rowsKept, colsKept, err := a.Trim(1e-9)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 2},
+        {3, 4},
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+    if !reflect.DeepEqual(rowsKept, []int{0, 2}) {
+        t.Fatalf("expected rows kept [0 2], got %v", rowsKept)
+    }
+    if !reflect.DeepEqual(colsKept, []int{0, 2}) {
+        t.Fatalf("expected columns kept [0 2], got %v", colsKept)
+    }
+
+    zero, err := NewZeroMatrix(2, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    _, _, _, err = zero.Trim(1e-9)
+    if !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions for all-zero matrix, got %v", err)
+    }
+}
+
+// TestFlatten tests that flattening preserves row-major order and copies the data.
+func TestFlatten(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    flat := a.Flatten()
+
+    expected := []float64{1, 2, 3, 4, 5, 6}
+    if !reflect.DeepEqual(flat, expected) {
+        t.Fatalf("expected %v, got %v", expected, flat)
+    }
+
+    flat[0] = 100
+    if a.Data[0][0] != 1 {
+        t.Fatalf("expected flattened slice to be independent of the matrix, got %v", a.Data)
+    }
+}
+
+// TestTriplets tests that a dense matrix can be rebuilt from its triplets.
+func TestTriplets(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {0, 2, 0},
+            {1e-12, 0, 3},
+            {4, 0, -5},
+        },
+    }
+
+    rows, cols, vals := a.Triplets(1e-9)
+
+    if !reflect.DeepEqual(rows, []int{0, 1, 2, 2}) {
+        t.Fatalf("expected rows [0 1 2 2], got %v", rows)
+    }
+    if !reflect.DeepEqual(cols, []int{1, 2, 0, 2}) {
+        t.Fatalf("expected columns [1 2 0 2], got %v", cols)
+    }
+
+    rebuilt, err := NewZeroMatrix(a.Rows, a.Cols)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for k := range vals {
+        rebuilt.Data[rows[k]][cols[k]] = vals[k]
+    }
+
+    expected := [][]float64{
+        {0, 2, 0},
+        {0, 0, 3},
+        {4, 0, -5},
+    }
+    if !reflect.DeepEqual(rebuilt.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, rebuilt.Data)
+    }
+}
+
+// TestDivide tests element-wise division and the divide-by-zero error.
+func TestDivide(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {2, 9},
+            {8, -6},
+        },
+    }
+    b := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 3},
+            {4, 2},
+        },
+    }
+    expected := [][]float64{
+        {2, 3},
+        {2, -3},
+    }
+
+    result, err := a.Divide(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    c := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 3},
+            {0, 2},
+        },
+    }
+
+    _, err = a.Divide(c)
+    if !errors.Is(err, ErrDivisionByZero) {
+        t.Fatalf("expected ErrDivisionByZero, got %v", err)
+    }
+    if !strings.Contains(err.Error(), "(1, 0)") {
+        t.Fatalf("expected error to name position (1, 0), got %q", err)
+    }
+
+    d := Matrix{
+        Rows: 1,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 3},
+        },
+    }
+
+    _, err = a.Divide(d)
+    if err == nil {
+        t.Fatal("expected error for matrices with different dimensions, but got none")
+    }
+}
+
+// TestMulVec tests the matrix-vector product against Multiply with a column matrix.
+func TestMulVec(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+    v := []float64{7, 8, 9}
+    column := Matrix{
+        Rows: 3,
+        Cols: 1,
+        Data: [][]float64{
+            {7},
+            {8},
+            {9},
+        },
+    }
+
+    result, err := a.MulVec(v)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected, err := a.Multiply(column)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    for i := range result {
+        if result[i] != expected.Data[i][0] {
+            t.Fatalf("expected %v, got %v", expected.Data, result)
+        }
+    }
+
+    _, err = a.MulVec([]float64{1, 2})
+    if err == nil {
+        t.Fatal("expected error for vector of wrong length, but got none")
+    }
+}
+
+// TestMapErr tests a successful fallible transform and one that fails on a specific value.
+func TestMapErr(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 4},
+            {9, 16},
+        },
+    }
+
+    sqrt := func(x float64) (float64, error) {
+        if x < 0 {
+            return 0, errors.New("negative input")
+        }
+        return math.Sqrt(x), nil
+    }
+
+    result, err := a.MapErr(sqrt)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 2},
+        {3, 4},
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    errNegative := errors.New("negative input")
+    failing := func(x float64) (float64, error) {
+        if x == 9 {
+            return 0, errNegative
+        }
+        return x, nil
+    }
+
+    _, err = a.MapErr(failing)
+    if err == nil {
+        t.Fatal("expected error from callback, but got none")
+    }
+    if !errors.Is(err, errNegative) {
+        t.Fatalf("expected error to wrap the callback error, got %v", err)
+    }
+    if !strings.Contains(err.Error(), "(1, 0)") {
+        t.Fatalf("expected error to name position (1, 0), got %q", err)
+    }
+}
+
+// TestVecMul tests the vector-matrix product against the transpose-based equivalent.
+func TestVecMul(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+    v := []float64{7, 8}
+
+    result, err := a.VecMul(v)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected, err := a.T().MulVec(v)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if !reflect.DeepEqual(result, expected) {
+        t.Fatalf("expected %v, got %v", expected, result)
+    }
+
+    _, err = a.VecMul([]float64{1, 2, 3})
+    if err == nil {
+        t.Fatal("expected error for vector of wrong length, but got none")
+    }
+}
+
+// TestApplyInPlace tests that the receiver is modified directly.
+func TestApplyInPlace(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+
+    a.ApplyInPlace(func(x float64) float64 { return x * 2 })
+
+    expected := [][]float64{
+        {2, 4},
+        {6, 8},
+    }
+    if !reflect.DeepEqual(a.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, a.Data)
+    }
+}
+
+// TestFill tests that every entry is overwritten without reallocating the rows.
+func TestFill(t *testing.T) {
+    a, err := NewRandomMatrix(3, 4, -1, 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    first := &a.Data[0][0]
+
+    a.Fill(2.5)
+
+    for i := range a.Data {
+        for j := range a.Data[i] {
+            if a.Data[i][j] != 2.5 {
+                t.Fatalf("expected every entry to be 2.5, got %f at (%d, %d)", a.Data[i][j], i, j)
+            }
+        }
+    }
+    if &a.Data[0][0] != first {
+        t.Fatal("expected Fill to reuse the existing rows")
+    }
+}
+
+// TestCopyFrom tests that the receiver's rows are reused and shapes are checked.
+func TestCopyFrom(t *testing.T) {
+    dest, err := NewZeroMatrix(2, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    first := &dest.Data[0][0]
+
+    src := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+    if err := dest.CopyFrom(src); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(dest.Data, src.Data) {
+        t.Fatalf("expected %v, got %v", src.Data, dest.Data)
+    }
+    if &dest.Data[0][0] != first {
+        t.Fatal("expected CopyFrom to reuse the existing rows")
+    }
+
+    // The copy does not share storage with the source
+    src.Data[0][0] = 100
+    if dest.Data[0][0] != 1 {
+        t.Fatal("expected CopyFrom to copy the values")
+    }
+
+    err = dest.CopyFrom(Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+func BenchmarkMap(b *testing.B) {
+    m, err := NewRandomMatrix(256, 256, -1, 1)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+    double := func(x float64) float64 { return x * 2 }
+
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        _, _ = m.Map(double)
+    }
+}
+
+func BenchmarkApplyInPlace(b *testing.B) {
+    m, err := NewRandomMatrix(256, 256, -1, 1)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+    double := func(x float64) float64 { return x * 2 }
+
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        m.ApplyInPlace(double)
+    }
+}
+
+// TestGradientStep tests a gradient step against scaling the gradient and adding it separately.
+func TestGradientStep(t *testing.T) {
+    m := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+    grad := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {0.5, -1, 2},
+            {4, 0, -8},
+        },
+    }
+    learningRate := 0.25
+
+    result, err := m.GradientStep(grad, learningRate)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    scaled, err := grad.Map(func(x float64) float64 { return -learningRate * x })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected, err := m.Add(scaled)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if !reflect.DeepEqual(result.Data, expected.Data) {
+        t.Fatalf("expected %v, got %v", expected.Data, result.Data)
+    }
+
+    _, err = m.GradientStep(m.T(), learningRate)
+    if err == nil {
+        t.Fatal("expected error for matrices with different dimensions, but got none")
+    }
+}
+
+// TestKronecker tests the Kronecker product of two 2x2 matrices.
+func TestKronecker(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+    b := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {0, 5},
+            {6, 7},
+        },
+    }
+    expected := [][]float64{
+        {0, 5, 0, 10},
+        {6, 7, 12, 14},
+        {0, 15, 0, 20},
+        {18, 21, 24, 28},
+    }
+
+    result := a.Kronecker(b)
+
+    if result.Rows != 4 || result.Cols != 4 {
+        t.Fatalf("expected dimensions (4, 4), got (%d, %d)", result.Rows, result.Cols)
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+}
+
+// TestPow tests integer powers of a 2x2 matrix.
+func TestPow(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 1},
+            {1, 0},
+        },
+    }
+
+    result, err := a.Pow(0)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    identity, err := NewIdentityMatrix(2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(result.Data, identity.Data) {
+        t.Fatalf("expected %v, got %v", identity.Data, result.Data)
+    }
+
+    result, err = a.Pow(1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(result.Data, a.Data) {
+        t.Fatalf("expected %v, got %v", a.Data, result.Data)
+    }
+
+    result, err = a.Pow(3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := [][]float64{
+        {3, 2},
+        {2, 1},
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    result, err = a.Pow(-1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected = [][]float64{
+        {0, 1},
+        {1, -1},
+    }
+    for i := range expected {
+        for j := range expected[i] {
+            if math.Abs(result.Data[i][j]-expected[i][j]) > 1e-9 {
+                t.Fatalf("expected %v, got %v", expected, result.Data)
+            }
+        }
+    }
+
+    c := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    _, err = c.Pow(2)
+    if err == nil {
+        t.Fatal("expected error for non-square matrix, but got none")
+    }
+}
+
+// TestDiagonal tests extracting the diagonal of a rectangular matrix.
+func TestDiagonal(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+
+    expected := []float64{1, 5}
+    if diagonal := a.Diagonal(); !reflect.DeepEqual(diagonal, expected) {
+        t.Fatalf("expected %v, got %v", expected, diagonal)
+    }
+
+    expected = []float64{1, 5}
+    if diagonal := a.T().Diagonal(); !reflect.DeepEqual(diagonal, expected) {
+        t.Fatalf("expected %v, got %v", expected, diagonal)
+    }
+}
+
+// TestNewDiagonalMatrix tests constructing a diagonal matrix from a slice.
+func TestNewDiagonalMatrix(t *testing.T) {
+    expected := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, 0, 0},
+            {0, -1, 0},
+            {0, 0, 5},
+        },
+    }
+
+    result := NewDiagonalMatrix([]float64{2, -1, 5})
+
+    if !reflect.DeepEqual(result, expected) {
+        t.Fatalf("expected %v, got %v", expected, result)
+    }
+}
+
+// TestIsSquare tests the squareness predicate.
+func TestIsSquare(t *testing.T) {
+    identity, err := NewIdentityMatrix(3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !identity.IsSquare() {
+        t.Fatal("expected 3x3 matrix to be square")
+    }
+
+    rectangle, err := NewZeroMatrix(2, 3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if rectangle.IsSquare() {
+        t.Fatal("expected 2x3 matrix not to be square")
+    }
+}
+
+// TestIsSymmetric tests the symmetry predicate with and without tolerance.
+func TestIsSymmetric(t *testing.T) {
+    symmetric := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {2, 4, 5},
+            {3, 5, 6},
+        },
+    }
+    if !symmetric.IsSymmetric(0) {
+        t.Fatal("expected matrix to be symmetric")
+    }
+
+    nearly := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2 + 1e-12},
+            {2, 1},
+        },
+    }
+    if !nearly.IsSymmetric(1e-9) {
+        t.Fatal("expected matrix to be symmetric within tolerance")
+    }
+    if nearly.IsSymmetric(0) {
+        t.Fatal("expected matrix not to be exactly symmetric")
+    }
+
+    rectangle, err := NewZeroMatrix(2, 3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if rectangle.IsSymmetric(1e-9) {
+        t.Fatal("expected non-square matrix not to be symmetric")
+    }
+}
+
+func TestHeadTail(t *testing.T) {
+    a, err := NewMatrixFromFunc(5, 2, func(i, j int) float64 { return float64(i*2 + j) })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    head := a.Head(2)
+    if expected := [][]float64{{0, 1}, {2, 3}}; head.Rows != 2 || !reflect.DeepEqual(head.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, head.Data)
+    }
+
+    tail := a.Tail(2)
+    if expected := [][]float64{{6, 7}, {8, 9}}; tail.Rows != 2 || !reflect.DeepEqual(tail.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, tail.Data)
+    }
+
+    if all := a.Head(10); !reflect.DeepEqual(all.Data, a.Data) {
+        t.Fatalf("expected Head to clamp to %v, got %v", a.Data, all.Data)
+    }
+    if all := a.Tail(10); !reflect.DeepEqual(all.Data, a.Data) {
+        t.Fatalf("expected Tail to clamp to %v, got %v", a.Data, all.Data)
+    }
+
+    // The result is a copy
+    head.Data[0][0] = 100
+    if a.Data[0][0] != 0 {
+        t.Fatal("expected Head to copy the rows")
+    }
+}
+
+func TestAXPY(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, -2},
+            {0.5, 3},
+        },
+    }
+    b := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {4, 1},
+            {-1, 2},
+        },
+    }
+    alpha := 2.5
+
+    scaled, err := a.Map(func(x float64) float64 { return alpha * x })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected, err := scaled.Add(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    result, err := a.AXPY(alpha, b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, expected.Data, result)
+
+    if err := a.AXPYInPlace(alpha, b); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, expected.Data, a)
+
+    mismatched := Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}
+    if _, err := a.AXPY(alpha, mismatched); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+    if err := a.AXPYInPlace(alpha, mismatched); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
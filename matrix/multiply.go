@@ -0,0 +1,101 @@
+package matrix
+
+import (
+    "fmt"
+    "runtime"
+    "sync"
+)
+
+// BlockedMultiplyThreshold is the dimension at which Multiply switches to the cache-blocked algorithm.
+// Smaller products use the straightforward triple loop, which has less overhead.
+var BlockedMultiplyThreshold = 128
+
+// MultiplyBlockSize is the edge length of the square tiles used by the cache-blocked multiplication.
+// Tiles of this size from both operands should fit in cache together.
+var MultiplyBlockSize = 64
+
+// multiplyNaive accumulates a*b into result using the textbook triple loop.
+func multiplyNaive(result, a, b [][]float64) {
+    for i := range a {
+        for j := range b[0] {
+            for k := range a[0] {
+                result[i][j] += a[i][k] * b[k][j]
+            }
+        }
+    }
+}
+
+// multiplyBlocked accumulates a*b into result one tile at a time.
+// Within each tile the loops run in i-k-j order so that rows of b and result are read contiguously.
+// Every result element still sums its products in increasing k, so the result matches multiplyNaive.
+func multiplyBlocked(result, a, b [][]float64, blockSize int) {
+    n, inner, p := len(a), len(b), len(b[0])
+
+    for ii := 0; ii < n; ii += blockSize {
+        iEnd := minInt(ii+blockSize, n)
+        for kk := 0; kk < inner; kk += blockSize {
+            kEnd := minInt(kk+blockSize, inner)
+            for jj := 0; jj < p; jj += blockSize {
+                jEnd := minInt(jj+blockSize, p)
+                for i := ii; i < iEnd; i++ {
+                    resultRow := result[i]
+                    for k := kk; k < kEnd; k++ {
+                        aik := a[i][k]
+                        bRow := b[k]
+                        for j := jj; j < jEnd; j++ {
+                            resultRow[j] += aik * bRow[j]
+                        }
+                    }
+                }
+            }
+        }
+    }
+}
+
+// minInt returns the smaller of a and b.
+func minInt(a, b int) int {
+    if a < b {
+        return a
+    }
+    return b
+}
+
+// maxInt returns the larger of a and b.
+func maxInt(a, b int) int {
+    if a > b {
+        return a
+    }
+    return b
+}
+
+// MultiplyParallel performs matrix multiplication, spreading the output rows across runtime.NumCPU() goroutines.
+// Each goroutine owns a contiguous band of output rows, so no two goroutines write to the same row.
+// Returns an error if matrices have incompatible dimensions.
+func (m Matrix) MultiplyParallel(other Matrix) (Matrix, error) {
+    if m.Cols != other.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot multiply %s by %s: inner dimensions %d and %d differ",
+            ErrDimensionMismatch, shape(m), shape(other), m.Cols, other.Rows)
+    }
+
+    result, err := NewZeroMatrix(m.Rows, other.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    workers := minInt(runtime.NumCPU(), m.Rows)
+    band := (m.Rows + workers - 1) / workers
+
+    var wg sync.WaitGroup
+    for start := 0; start < m.Rows; start += band {
+        end := minInt(start+band, m.Rows)
+        wg.Add(1)
+        go func(start, end int) {
+            defer wg.Done()
+            multiplyBlocked(result.Data[start:end], m.Data[start:end], other.Data, MultiplyBlockSize)
+        }(start, end)
+    }
+    wg.Wait()
+
+    return result, nil
+}
@@ -0,0 +1,139 @@
+package matrix
+
+import (
+    "reflect"
+    "testing"
+)
+
+// TestMultiplyBlocked tests the blocked algorithm against the naive one with ragged tiles.
+func TestMultiplyBlocked(t *testing.T) {
+    a, err := NewRandomMatrix(70, 45, -1, 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    b, err := NewRandomMatrix(45, 33, -1, 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    naive, err := NewZeroMatrix(70, 33)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    blocked, err := NewZeroMatrix(70, 33)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    multiplyNaive(naive.Data, a.Data, b.Data)
+    multiplyBlocked(blocked.Data, a.Data, b.Data, 16)
+
+    if !reflect.DeepEqual(naive.Data, blocked.Data) {
+        t.Fatal("expected blocked multiplication to match naive multiplication")
+    }
+}
+
+// TestMultiplyLarge tests that Multiply gives the same result above the blocking threshold.
+func TestMultiplyLarge(t *testing.T) {
+    n := BlockedMultiplyThreshold + 5
+    a, err := NewRandomMatrix(n, n, -1, 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    result, err := a.Multiply(a)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected, err := NewZeroMatrix(n, n)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    multiplyNaive(expected.Data, a.Data, a.Data)
+
+    assertClose(t, expected.Data, result)
+}
+
+func benchmarkMultiply(b *testing.B, multiply func(result, x, y [][]float64)) {
+    x, err := NewRandomMatrix(512, 512, -1, 1)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+    y, err := NewRandomMatrix(512, 512, -1, 1)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        result, _ := NewZeroMatrix(512, 512)
+        multiply(result.Data, x.Data, y.Data)
+    }
+}
+
+func BenchmarkMultiplyNaive512(b *testing.B) {
+    benchmarkMultiply(b, multiplyNaive)
+}
+
+func BenchmarkMultiplyBlocked512(b *testing.B) {
+    benchmarkMultiply(b, func(result, x, y [][]float64) {
+        multiplyBlocked(result, x, y, MultiplyBlockSize)
+    })
+}
+
+// integerMatrix returns a matrix of small integers following a fixed pattern.
+func integerMatrix(rows, cols int) Matrix {
+    m, err := NewZeroMatrix(rows, cols)
+    if err != nil {
+        panic(err)
+    }
+    for i := range m.Data {
+        for j := range m.Data[i] {
+            m.Data[i][j] = float64((i*7+j*3)%11 - 5)
+        }
+    }
+    return m
+}
+
+// TestMultiplyParallel tests the parallel multiplication against the serial one on integer values.
+func TestMultiplyParallel(t *testing.T) {
+    a := integerMatrix(97, 61)
+    b := integerMatrix(61, 83)
+
+    expected, err := a.Multiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    result, err := a.MultiplyParallel(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if !reflect.DeepEqual(result.Data, expected.Data) {
+        t.Fatal("expected parallel multiplication to match serial multiplication")
+    }
+
+    _, err = a.MultiplyParallel(a)
+    if err == nil {
+        t.Fatal("expected error for matrices with incompatible dimensions, but got none")
+    }
+}
+
+func BenchmarkMultiplySerial1024(b *testing.B) {
+    x := integerMatrix(1024, 1024)
+
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        _, _ = x.Multiply(x)
+    }
+}
+
+func BenchmarkMultiplyParallel1024(b *testing.B) {
+    x := integerMatrix(1024, 1024)
+
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        _, _ = x.MultiplyParallel(x)
+    }
+}
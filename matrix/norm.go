@@ -0,0 +1,171 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+)
+
+// FrobeniusNorm returns the square root of the sum of the squares of all elements.
+func (m Matrix) FrobeniusNorm() float64 {
+    return math.Sqrt(m.SquaredFrobeniusNorm())
+}
+
+// SquaredFrobeniusNorm returns the sum of the squares of all elements, which is FrobeniusNorm squared
+// and also the trace of AᵀA. Comparing it against a squared threshold avoids the square root and its rounding.
+func (m Matrix) SquaredFrobeniusNorm() float64 {
+    total := 0.0
+    m.eachRun(func(run []float64) {
+        total += sumOfSquares(run)
+    })
+    return total
+}
+
+// ClipByNorm rescales the matrix so its Frobenius norm does not exceed maxNorm.
+// If the norm is already within maxNorm, including for a zero matrix, the values are returned unchanged.
+// The result is always a new matrix that does not share storage with the receiver.
+func (m Matrix) ClipByNorm(maxNorm float64) Matrix {
+    norm := m.FrobeniusNorm()
+    if norm <= maxNorm || norm == 0 {
+        return m.clone()
+    }
+
+    scale := maxNorm / norm
+    return m.mapOrPanic(func(x float64) float64 { return x * scale })
+}
+
+// NormKind selects the matrix norm used by Norm and Cond.
+type NormKind int
+
+const (
+    // NormFrobenius is the square root of the sum of the squares of all elements.
+    NormFrobenius NormKind = iota
+    // NormOne is the maximum absolute column sum.
+    NormOne
+    // NormInf is the maximum absolute row sum.
+    NormInf
+    // NormTwo is the spectral norm, the largest singular value.
+    NormTwo
+)
+
+// Norm returns the chosen norm of the matrix.
+// Returns an error if kind is not a known NormKind or the SVD needed by NormTwo does not converge.
+func (m Matrix) Norm(kind NormKind) (float64, error) {
+    switch kind {
+    case NormFrobenius:
+        return m.FrobeniusNorm(), nil
+    case NormOne:
+        largest := 0.0
+        for j := 0; j < m.Cols; j++ {
+            sum := 0.0
+            for i := range m.Data {
+                sum += math.Abs(m.Data[i][j])
+            }
+            largest = math.Max(largest, sum)
+        }
+        return largest, nil
+    case NormInf:
+        largest := 0.0
+        for _, row := range m.Data {
+            sum := 0.0
+            for _, val := range row {
+                sum += math.Abs(val)
+            }
+            largest = math.Max(largest, sum)
+        }
+        return largest, nil
+    case NormTwo:
+        _, S, _, err := m.SVD()
+        if err != nil {
+            return 0, err
+        }
+        return S.Data[0][0], nil
+    }
+    return 0, fmt.Errorf("%w: unknown norm kind %d", ErrOutOfRange, kind)
+}
+
+// Cond returns the condition number of a square matrix, the product of the chosen norm of A and of its inverse.
+// Large values warn that solving systems with the matrix can amplify rounding errors by that factor.
+// Returns an error if the matrix is not square or is singular, or if kind is not a known NormKind.
+func (m Matrix) Cond(kind NormKind) (float64, error) {
+    if m.Rows != m.Cols {
+        return 0, notSquare("Cond", m)
+    }
+
+    norm, err := m.Norm(kind)
+    if err != nil {
+        return 0, err
+    }
+    inverse, err := m.Inverse()
+    if err != nil {
+        return 0, err
+    }
+    inverseNorm, err := inverse.Norm(kind)
+    if err != nil {
+        return 0, err
+    }
+
+    return norm * inverseNorm, nil
+}
+
+// columnNorms returns the Euclidean norm of every column.
+// Returns an error wrapping ErrSingular and naming op if any column is entirely zero.
+func (m Matrix) columnNorms(op string) ([]float64, error) {
+    norms := make([]float64, m.Cols)
+    for _, row := range m.Data {
+        for j, val := range row {
+            norms[j] += val * val
+        }
+    }
+    for j := range norms {
+        if norms[j] == 0 {
+            return nil, fmt.Errorf("%w: %s: column %d is all zeros", ErrSingular, op, j)
+        }
+        norms[j] = math.Sqrt(norms[j])
+    }
+    return norms, nil
+}
+
+// NormalizeColumns returns a copy of the matrix with every column scaled to unit Euclidean norm.
+// Returns an error if any column is all zeros, since it has no direction to keep.
+func (m Matrix) NormalizeColumns() (Matrix, error) {
+    norms, err := m.columnNorms("NormalizeColumns")
+    if err != nil {
+        return Matrix{}, err
+    }
+
+    result := m.clone()
+    for _, row := range result.Data {
+        for j := range row {
+            row[j] /= norms[j]
+        }
+    }
+    return result, nil
+}
+
+// ColumnCosineSimilarity returns the Cols×Cols matrix whose entry (i, j) is the cosine of the angle
+// between columns i and j. The result is symmetric with ones on the diagonal.
+// Returns an error if any column is all zeros, since its angle to other columns is undefined.
+func (m Matrix) ColumnCosineSimilarity() (Matrix, error) {
+    norms, err := m.columnNorms("ColumnCosineSimilarity")
+    if err != nil {
+        return Matrix{}, err
+    }
+
+    result, err := NewIdentityMatrix(m.Cols)
+    if err != nil {
+        panic(err)
+    }
+    for i := 0; i < m.Cols; i++ {
+        for j := i + 1; j < m.Cols; j++ {
+            dot := 0.0
+            for _, row := range m.Data {
+                dot += row[i] * row[j]
+            }
+            cosine := dot / (norms[i] * norms[j])
+            result.Data[i][j] = cosine
+            result.Data[j][i] = cosine
+        }
+    }
+
+    return result, nil
+}
@@ -0,0 +1,216 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "reflect"
+    "testing"
+)
+
+func TestFrobeniusNorm(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, -2},
+            {2, 4},
+        },
+    }
+
+    if norm := a.FrobeniusNorm(); norm != 5 {
+        t.Fatalf("expected norm 5, got %f", norm)
+    }
+}
+
+func TestSquaredFrobeniusNorm(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {0.3, -1.7, 2},
+            {4.1, 0, -0.25},
+        },
+    }
+
+    squared := a.SquaredFrobeniusNorm()
+    norm := a.FrobeniusNorm()
+    if math.Abs(squared-norm*norm) > 1e-12 {
+        t.Fatalf("expected %f, got %f", norm*norm, squared)
+    }
+}
+
+func TestClipByNorm(t *testing.T) {
+    a := Matrix{
+        Rows: 1,
+        Cols: 2,
+        Data: [][]float64{
+            {3, 4},
+        },
+    }
+
+    clipped := a.ClipByNorm(1)
+    expected := [][]float64{{0.6, 0.8}}
+    for j := range expected[0] {
+        if math.Abs(clipped.Data[0][j]-expected[0][j]) > 1e-9 {
+            t.Fatalf("expected %v, got %v", expected, clipped.Data)
+        }
+    }
+    if math.Abs(clipped.FrobeniusNorm()-1) > 1e-9 {
+        t.Fatalf("expected norm 1, got %f", clipped.FrobeniusNorm())
+    }
+
+    unchanged := a.ClipByNorm(10)
+    if !reflect.DeepEqual(unchanged.Data, a.Data) {
+        t.Fatalf("expected %v, got %v", a.Data, unchanged.Data)
+    }
+
+    zero, err := NewZeroMatrix(2, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(zero.ClipByNorm(0).Data, zero.Data) {
+        t.Fatalf("expected zero matrix to be unchanged")
+    }
+}
+
+func TestNorm(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, -2},
+            {2, 4},
+        },
+    }
+
+    tests := []struct {
+        kind     NormKind
+        expected float64
+    }{
+        {NormFrobenius, 5},
+        {NormOne, 6},
+        {NormInf, 6},
+        {NormTwo, math.Sqrt((25 + math.Sqrt(625-4*64)) / 2)},
+    }
+
+    for _, test := range tests {
+        norm, err := a.Norm(test.kind)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if math.Abs(norm-test.expected) > 1e-9 {
+            t.Fatalf("expected norm %f for kind %d, got %f", test.expected, test.kind, norm)
+        }
+    }
+
+    if _, err := a.Norm(NormKind(-1)); err == nil {
+        t.Fatal("expected error for unknown norm kind, but got none")
+    }
+}
+
+func TestCond(t *testing.T) {
+    identity, err := NewIdentityMatrix(3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for _, kind := range []NormKind{NormOne, NormInf, NormTwo} {
+        cond, err := identity.Cond(kind)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if math.Abs(cond-1) > 1e-9 {
+            t.Fatalf("expected condition number 1 for kind %d, got %f", kind, cond)
+        }
+    }
+
+    nearlySingular := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 1},
+            {1, 1 + 1e-8},
+        },
+    }
+    cond, err := nearlySingular.Cond(NormOne)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if cond < 1e8 {
+        t.Fatalf("expected a condition number above 1e8, got %g", cond)
+    }
+
+    singular := Matrix{Rows: 2, Cols: 2, Data: [][]float64{{1, 2}, {2, 4}}}
+    if _, err := singular.Cond(NormOne); !errors.Is(err, ErrSingular) {
+        t.Fatalf("expected ErrSingular, got %v", err)
+    }
+
+    if _, err := (Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}).Cond(NormOne); !errors.Is(err, ErrNotSquare) {
+        t.Fatalf("expected ErrNotSquare, got %v", err)
+    }
+}
+
+func TestNormalizeColumns(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {3, 0, -1},
+            {4, 2, 1},
+        },
+    }
+
+    result, err := a.NormalizeColumns()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, [][]float64{{0.6, 0, -math.Sqrt2 / 2}, {0.8, 1, math.Sqrt2 / 2}}, result)
+
+    for j := 0; j < result.Cols; j++ {
+        norm := math.Hypot(result.Data[0][j], result.Data[1][j])
+        if math.Abs(norm-1) > 1e-12 {
+            t.Fatalf("expected column %d to have norm 1, got %f", j, norm)
+        }
+    }
+
+    zeroColumn := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 0},
+            {2, 0},
+        },
+    }
+    if _, err := zeroColumn.NormalizeColumns(); !errors.Is(err, ErrSingular) {
+        t.Fatalf("expected ErrSingular for zero column, got %v", err)
+    }
+}
+
+func TestColumnCosineSimilarity(t *testing.T) {
+    // Columns 0 and 1 are parallel, column 2 is orthogonal to both, and column 3 points opposite column 0
+    a := Matrix{
+        Rows: 2,
+        Cols: 4,
+        Data: [][]float64{
+            {1, 3, -2, -1},
+            {2, 6, 1, -2},
+        },
+    }
+
+    result, err := a.ColumnCosineSimilarity()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 1, 0, -1},
+        {1, 1, 0, -1},
+        {0, 0, 1, 0},
+        {-1, -1, 0, 1},
+    }
+    assertClose(t, expected, result)
+
+    zeroColumn := Matrix{Rows: 2, Cols: 2, Data: [][]float64{{1, 0}, {2, 0}}}
+    if _, err := zeroColumn.ColumnCosineSimilarity(); !errors.Is(err, ErrSingular) {
+        t.Fatalf("expected ErrSingular for zero column, got %v", err)
+    }
+}
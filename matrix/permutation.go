@@ -0,0 +1,87 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// validatePermutation returns an error unless perm contains each of 0..n-1 exactly once.
+func validatePermutation(perm []int, n int) error {
+    if len(perm) != n {
+        return fmt.Errorf("%w: expected permutation of length %d, got %d", ErrDimensionMismatch, n, len(perm))
+    }
+
+    seen := make([]bool, n)
+    for _, p := range perm {
+        if p < 0 || p >= n {
+            return fmt.Errorf("%w: permutation index %d", ErrOutOfRange, p)
+        }
+        if seen[p] {
+            return fmt.Errorf("%w: repeated index %d", ErrInvalidPermutation, p)
+        }
+        seen[p] = true
+    }
+
+    return nil
+}
+
+// PermuteRows returns a new matrix whose row i is row perm[i] of the original.
+// Returns an error if perm is not a permutation of 0..Rows-1.
+func (m Matrix) PermuteRows(perm []int) (Matrix, error) {
+    if err := validatePermutation(perm, m.Rows); err != nil {
+        return Matrix{}, err
+    }
+
+    data := make([][]float64, m.Rows)
+    for i, p := range perm {
+        data[i] = make([]float64, m.Cols)
+        copy(data[i], m.Data[p])
+    }
+
+    return Matrix{Rows: m.Rows, Cols: m.Cols, Data: data}, nil
+}
+
+// PermuteColumns returns a new matrix whose column j is column perm[j] of the original.
+// Returns an error if perm is not a permutation of 0..Cols-1.
+func (m Matrix) PermuteColumns(perm []int) (Matrix, error) {
+    if err := validatePermutation(perm, m.Cols); err != nil {
+        return Matrix{}, err
+    }
+
+    result, err := NewZeroMatrix(m.Rows, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range m.Data {
+        for j, p := range perm {
+            result.Data[i][j] = m.Data[i][p]
+        }
+    }
+
+    return result, nil
+}
+
+// NewPermutationMatrix returns the n×n permutation matrix P for a permutation of 0..n-1.
+// Row i of P has its single 1 in column perm[i], so P.Multiply(A) equals A.PermuteRows(perm).
+// Returns an error if perm is empty or is not a permutation of 0..len(perm)-1.
+func NewPermutationMatrix(perm []int) (Matrix, error) {
+    if len(perm) == 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+    if err := validatePermutation(perm, len(perm)); err != nil {
+        return Matrix{}, err
+    }
+
+    result, err := NewZeroMatrix(len(perm), len(perm))
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i, p := range perm {
+        result.Data[i][p] = 1
+    }
+
+    return result, nil
+}
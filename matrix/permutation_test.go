@@ -0,0 +1,144 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+// inversePermutation returns the permutation that undoes perm.
+func inversePermutation(perm []int) []int {
+    inverse := make([]int, len(perm))
+    for i, p := range perm {
+        inverse[p] = i
+    }
+    return inverse
+}
+
+func TestPermuteRows(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+            {5, 6},
+        },
+    }
+    perm := []int{2, 0, 1}
+
+    permuted, err := a.PermuteRows(perm)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {5, 6},
+        {1, 2},
+        {3, 4},
+    }
+    if !reflect.DeepEqual(permuted.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, permuted.Data)
+    }
+
+    restored, err := permuted.PermuteRows(inversePermutation(perm))
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(restored.Data, a.Data) {
+        t.Fatalf("expected %v, got %v", a.Data, restored.Data)
+    }
+
+    _, err = a.PermuteRows([]int{0, 0, 1})
+    if !errors.Is(err, ErrInvalidPermutation) {
+        t.Fatalf("expected ErrInvalidPermutation for repeated index, got %v", err)
+    }
+
+    _, err = a.PermuteRows([]int{0, 1})
+    if err == nil {
+        t.Fatal("expected error for permutation of wrong length, but got none")
+    }
+}
+
+func TestPermuteColumns(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+        },
+    }
+    perm := []int{1, 2, 0}
+
+    permuted, err := a.PermuteColumns(perm)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {2, 3, 1},
+        {5, 6, 4},
+    }
+    if !reflect.DeepEqual(permuted.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, permuted.Data)
+    }
+
+    restored, err := permuted.PermuteColumns(inversePermutation(perm))
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(restored.Data, a.Data) {
+        t.Fatalf("expected %v, got %v", a.Data, restored.Data)
+    }
+
+    _, err = a.PermuteColumns([]int{0, 1, 3})
+    if err == nil {
+        t.Fatal("expected error for out-of-range index, but got none")
+    }
+}
+
+func TestNewPermutationMatrix(t *testing.T) {
+    perm := []int{2, 0, 1}
+    p, err := NewPermutationMatrix(perm)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {0, 0, 1},
+        {1, 0, 0},
+        {0, 1, 0},
+    }
+    if !reflect.DeepEqual(p.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, p.Data)
+    }
+
+    a := Matrix{
+        Rows: 3,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+            {5, 6},
+        },
+    }
+    product, err := p.Multiply(a)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    permuted, err := a.PermuteRows(perm)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(product.Data, permuted.Data) {
+        t.Fatalf("expected P*A = %v, got %v", permuted.Data, product.Data)
+    }
+
+    if _, err := NewPermutationMatrix([]int{0, 1, 1}); !errors.Is(err, ErrInvalidPermutation) {
+        t.Fatalf("expected ErrInvalidPermutation for repeated index, got %v", err)
+    }
+    if _, err := NewPermutationMatrix([]int{0, 3, 1}); err == nil {
+        t.Fatal("expected error for out-of-range index, but got none")
+    }
+}
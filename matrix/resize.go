@@ -0,0 +1,115 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// DeleteRow returns a new matrix with row i removed.
+// Returns an error if the row index is out of range or the matrix has only one row,
+// since a matrix cannot have zero rows.
+func (m Matrix) DeleteRow(i int) (Matrix, error) {
+    if i < 0 || i >= m.Rows {
+        return Matrix{}, fmt.Errorf("%w: row %d in %s matrix", ErrOutOfRange, i, shape(m))
+    }
+    if m.Rows == 1 {
+        return Matrix{}, fmt.Errorf("%w: cannot delete the only row", ErrInvalidDimensions)
+    }
+
+    result, err := NewZeroMatrix(m.Rows-1, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for r := range result.Data {
+        source := r
+        if r >= i {
+            source++
+        }
+        copy(result.Data[r], m.Data[source])
+    }
+
+    return result, nil
+}
+
+// DeleteCol returns a new matrix with column j removed.
+// Returns an error if the column index is out of range or the matrix has only one column,
+// since a matrix cannot have zero columns.
+func (m Matrix) DeleteCol(j int) (Matrix, error) {
+    if j < 0 || j >= m.Cols {
+        return Matrix{}, fmt.Errorf("%w: column %d in %s matrix", ErrOutOfRange, j, shape(m))
+    }
+    if m.Cols == 1 {
+        return Matrix{}, fmt.Errorf("%w: cannot delete the only column", ErrInvalidDimensions)
+    }
+
+    result, err := NewZeroMatrix(m.Rows, m.Cols-1)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for r, row := range m.Data {
+        copy(result.Data[r], row[:j])
+        copy(result.Data[r][j:], row[j+1:])
+    }
+
+    return result, nil
+}
+
+// InsertRow returns a new matrix with values inserted as row i, shifting later rows down.
+// Inserting at i = Rows appends the row. The values are copied.
+// Returns an error if i is outside [0, Rows] or len(values) differs from Cols.
+func (m Matrix) InsertRow(i int, values []float64) (Matrix, error) {
+    if i < 0 || i > m.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot insert row at %d in %s matrix", ErrOutOfRange, i, shape(m))
+    }
+    if len(values) != m.Cols {
+        return Matrix{}, fmt.Errorf("%w: cannot insert row of %d values into %s matrix", ErrDimensionMismatch, len(values), shape(m))
+    }
+
+    result, err := NewZeroMatrix(m.Rows+1, m.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for r := range result.Data {
+        switch {
+        case r < i:
+            copy(result.Data[r], m.Data[r])
+        case r == i:
+            copy(result.Data[r], values)
+        default:
+            copy(result.Data[r], m.Data[r-1])
+        }
+    }
+
+    return result, nil
+}
+
+// InsertCol returns a new matrix with values inserted as column j, shifting later columns right.
+// Inserting at j = Cols appends the column.
+// Returns an error if j is outside [0, Cols] or len(values) differs from Rows.
+func (m Matrix) InsertCol(j int, values []float64) (Matrix, error) {
+    if j < 0 || j > m.Cols {
+        return Matrix{}, fmt.Errorf("%w: cannot insert column at %d in %s matrix", ErrOutOfRange, j, shape(m))
+    }
+    if len(values) != m.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot insert column of %d values into %s matrix", ErrDimensionMismatch, len(values), shape(m))
+    }
+
+    result, err := NewZeroMatrix(m.Rows, m.Cols+1)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for r, row := range m.Data {
+        copy(result.Data[r], row[:j])
+        result.Data[r][j] = values[r]
+        copy(result.Data[r][j+1:], row[j:])
+    }
+
+    return result, nil
+}
@@ -0,0 +1,128 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+var resizeInput = Matrix{
+    Rows: 3,
+    Cols: 3,
+    Data: [][]float64{
+        {1, 2, 3},
+        {4, 5, 6},
+        {7, 8, 9},
+    },
+}
+
+func TestDeleteRow(t *testing.T) {
+    result, err := resizeInput.DeleteRow(1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := [][]float64{
+        {1, 2, 3},
+        {7, 8, 9},
+    }
+    if result.Rows != 2 || !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    _, err = resizeInput.DeleteRow(3)
+    if !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+
+    _, err = Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}.DeleteRow(0)
+    if !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions for the only row, got %v", err)
+    }
+}
+
+func TestDeleteCol(t *testing.T) {
+    result, err := resizeInput.DeleteCol(1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := [][]float64{
+        {1, 3},
+        {4, 6},
+        {7, 9},
+    }
+    if result.Cols != 2 || !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    _, err = resizeInput.DeleteCol(-1)
+    if !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+
+    _, err = Matrix{Rows: 2, Cols: 1, Data: [][]float64{{1}, {2}}}.DeleteCol(0)
+    if !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions for the only column, got %v", err)
+    }
+}
+
+func TestInsertRow(t *testing.T) {
+    a := Matrix{Rows: 2, Cols: 2, Data: [][]float64{{1, 2}, {3, 4}}}
+    values := []float64{0, 0}
+
+    tests := []struct {
+        i        int
+        expected [][]float64
+    }{
+        {0, [][]float64{{0, 0}, {1, 2}, {3, 4}}},
+        {1, [][]float64{{1, 2}, {0, 0}, {3, 4}}},
+        {2, [][]float64{{1, 2}, {3, 4}, {0, 0}}},
+    }
+
+    for _, test := range tests {
+        result, err := a.InsertRow(test.i, values)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if result.Rows != 3 || !reflect.DeepEqual(result.Data, test.expected) {
+            t.Fatalf("inserting at %d: expected %v, got %v", test.i, test.expected, result.Data)
+        }
+    }
+
+    if _, err := a.InsertRow(3, values); !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+    if _, err := a.InsertRow(0, []float64{1}); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+func TestInsertCol(t *testing.T) {
+    a := Matrix{Rows: 2, Cols: 2, Data: [][]float64{{1, 2}, {3, 4}}}
+    values := []float64{8, 9}
+
+    tests := []struct {
+        j        int
+        expected [][]float64
+    }{
+        {0, [][]float64{{8, 1, 2}, {9, 3, 4}}},
+        {1, [][]float64{{1, 8, 2}, {3, 9, 4}}},
+        {2, [][]float64{{1, 2, 8}, {3, 4, 9}}},
+    }
+
+    for _, test := range tests {
+        result, err := a.InsertCol(test.j, values)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if result.Cols != 3 || !reflect.DeepEqual(result.Data, test.expected) {
+            t.Fatalf("inserting at %d: expected %v, got %v", test.j, test.expected, result.Data)
+        }
+    }
+
+    if _, err := a.InsertCol(-1, values); !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+    if _, err := a.InsertCol(0, []float64{1, 2, 3}); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
@@ -0,0 +1,160 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// Solve returns the matrix X satisfying AX = b, where each column of b is a right-hand side.
+// It uses an LU factorization with partial pivoting.
+// Returns an error if the matrix is not square, is singular, or b has the wrong number of rows.
+func (m Matrix) Solve(b Matrix) (Matrix, error) {
+    if m.Rows != m.Cols {
+        return Matrix{}, notSquare("Solve", m)
+    }
+    if b.Rows != m.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot solve %s system with %s right-hand side",
+            ErrDimensionMismatch, shape(m), shape(b))
+    }
+
+    return luDecompose(m).solve(b)
+}
+
+// solve returns the matrix X satisfying AX = b using the factorization, one column of b at a time.
+// Returns ErrSingular if the factorization is singular.
+func (d luDecomposition) solve(b Matrix) (Matrix, error) {
+    if d.singular {
+        return Matrix{}, ErrSingular
+    }
+
+    result, err := NewZeroMatrix(b.Rows, b.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    column := make([]float64, b.Rows)
+    for j := 0; j < b.Cols; j++ {
+        for i := range b.Data {
+            column[i] = b.Data[i][j]
+        }
+        x := d.solveColumn(column)
+        for i := range x {
+            result.Data[i][j] = x[i]
+        }
+    }
+
+    return result, nil
+}
+
+// SolveWithPivotThreshold solves AX = b like Solve, but with a relaxed pivoting threshold between 0 and 1.
+// During elimination the diagonal entry is kept as the pivot whenever its magnitude is at least threshold
+// times the largest magnitude below it, avoiding a row swap. A threshold of 1 is the ordinary partial
+// pivoting used by Solve; smaller thresholds swap less often, which preserves row order and sparsity but
+// lets the multipliers grow up to 1/threshold, so rounding errors can be amplified on ill-conditioned systems.
+// Returns an error if the threshold is outside [0, 1], the matrix is not square, is singular,
+// or b has the wrong number of rows.
+func (m Matrix) SolveWithPivotThreshold(b Matrix, threshold float64) (Matrix, error) {
+    if threshold < 0 || threshold > 1 {
+        return Matrix{}, fmt.Errorf("%w: pivot threshold must be between 0 and 1, got %g", ErrOutOfRange, threshold)
+    }
+    if m.Rows != m.Cols {
+        return Matrix{}, notSquare("SolveWithPivotThreshold", m)
+    }
+    if b.Rows != m.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot solve %s system with %s right-hand side",
+            ErrDimensionMismatch, shape(m), shape(b))
+    }
+
+    return luDecomposeThreshold(m, threshold).solve(b)
+}
+
+// block returns a copy of the rows [r0, r1) and columns [c0, c1) of the matrix.
+func (m Matrix) block(r0, r1, c0, c1 int) Matrix {
+    data := make([][]float64, r1-r0)
+    for i := range data {
+        data[i] = make([]float64, c1-c0)
+        copy(data[i], m.Data[r0+i][c0:c1])
+    }
+    return Matrix{Rows: r1 - r0, Cols: c1 - c0, Data: data}
+}
+
+// SolveBlockTriangular solves AX = b for a block upper triangular matrix A.
+// blockSizes gives the sizes of the square diagonal blocks from top to bottom. The system is solved
+// by back substitution one block at a time, so only the diagonal blocks are factored.
+// Entries below the diagonal blocks are assumed to be zero and are never read.
+// Returns an error if the matrix is not square, the block sizes are not positive or do not sum to Rows,
+// b has the wrong number of rows, or a diagonal block is singular.
+func (m Matrix) SolveBlockTriangular(b Matrix, blockSizes []int) (Matrix, error) {
+    if m.Rows != m.Cols {
+        return Matrix{}, notSquare("SolveBlockTriangular", m)
+    }
+    if b.Rows != m.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot solve %s system with %s right-hand side",
+            ErrDimensionMismatch, shape(m), shape(b))
+    }
+
+    starts := make([]int, len(blockSizes)+1)
+    for k, size := range blockSizes {
+        if size <= 0 {
+            return Matrix{}, fmt.Errorf("%w: block sizes must be positive, got %d", ErrInvalidDimensions, size)
+        }
+        starts[k+1] = starts[k] + size
+    }
+    if starts[len(blockSizes)] != m.Rows {
+        return Matrix{}, fmt.Errorf("%w: block sizes sum to %d but matrix has %d rows",
+            ErrDimensionMismatch, starts[len(blockSizes)], m.Rows)
+    }
+
+    result, err := NewZeroMatrix(m.Rows, b.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for k := len(blockSizes) - 1; k >= 0; k-- {
+        r0, r1 := starts[k], starts[k+1]
+
+        // Subtract the contributions of the blocks to the right, which are already solved
+        rhs := b.block(r0, r1, 0, b.Cols)
+        for i := r0; i < r1; i++ {
+            for j := r1; j < m.Cols; j++ {
+                for c := 0; c < b.Cols; c++ {
+                    rhs.Data[i-r0][c] -= m.Data[i][j] * result.Data[j][c]
+                }
+            }
+        }
+
+        x, err := m.block(r0, r1, r0, r1).Solve(rhs)
+        if err != nil {
+            return Matrix{}, err
+        }
+        for i := range x.Data {
+            copy(result.Data[r0+i], x.Data[i])
+        }
+    }
+
+    return result, nil
+}
+
+// LstSq returns the matrix X minimizing ||AX - b|| for a tall matrix A, where each column of b is a right-hand side.
+// It solves the normal equations AᵀAX = Aᵀb, which squares the condition number of A; use Pinv
+// for ill-conditioned or rank-deficient systems.
+// Returns an error if A has fewer rows than columns, b has the wrong number of rows,
+// or the columns of A are linearly dependent.
+func (m Matrix) LstSq(b Matrix) (Matrix, error) {
+    if m.Rows < m.Cols {
+        return Matrix{}, fmt.Errorf("%w: LstSq requires at least as many rows as columns, got %s",
+            ErrDimensionMismatch, shape(m))
+    }
+    if b.Rows != m.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot solve %s system with %s right-hand side",
+            ErrDimensionMismatch, shape(m), shape(b))
+    }
+
+    rhs, err := m.T().Multiply(b)
+    if err != nil {
+        panic(err)
+    }
+
+    return m.gram().Solve(rhs)
+}
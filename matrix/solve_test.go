@@ -0,0 +1,184 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+func TestSolve(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, 1, -1},
+            {-3, -1, 2},
+            {-2, 1, 2},
+        },
+    }
+    b := Matrix{
+        Rows: 3,
+        Cols: 1,
+        Data: [][]float64{
+            {8},
+            {-11},
+            {-3},
+        },
+    }
+
+    x, err := a.Solve(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, [][]float64{{2}, {3}, {-1}}, x)
+
+    singular := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {2, 4},
+        },
+    }
+
+    _, err = singular.Solve(Matrix{Rows: 2, Cols: 1, Data: [][]float64{{1}, {2}}})
+    if !errors.Is(err, ErrSingular) {
+        t.Fatalf("expected ErrSingular, got %v", err)
+    }
+
+    _, err = a.Solve(Matrix{Rows: 2, Cols: 1, Data: [][]float64{{1}, {2}}})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+// TestSolveBlockTriangular tests a block upper triangular solve against a full Solve.
+func TestSolveBlockTriangular(t *testing.T) {
+    a := Matrix{
+        Rows: 5,
+        Cols: 5,
+        Data: [][]float64{
+            {4, 1, 2, 0, 1},
+            {1, 3, -1, 2, 0},
+            {0, 0, 5, 1, 3},
+            {0, 0, 0, 2, 1},
+            {0, 0, 0, 1, 3},
+        },
+    }
+    b := Matrix{
+        Rows: 5,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 0},
+            {2, 1},
+            {3, -1},
+            {4, 2},
+            {5, 0},
+        },
+    }
+
+    expected, err := a.Solve(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    result, err := a.SolveBlockTriangular(b, []int{2, 1, 2})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    assertClose(t, expected.Data, result)
+
+    _, err = a.SolveBlockTriangular(b, []int{2, 2})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch for block sizes not summing to rows, got %v", err)
+    }
+}
+
+// TestSolveWithPivotThreshold tests that a relaxed threshold avoids swaps yet still solves the system.
+func TestSolveWithPivotThreshold(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {3, 1, 0},
+            {4, 5, 1},
+            {0, 2, 6},
+        },
+    }
+    b := Matrix{
+        Rows: 3,
+        Cols: 1,
+        Data: [][]float64{
+            {5},
+            {17},
+            {22},
+        },
+    }
+
+    if swaps := luDecomposeThreshold(a, 1).swaps; swaps == 0 {
+        t.Fatal("expected ordinary partial pivoting to swap rows")
+    }
+    if swaps := luDecomposeThreshold(a, 0.5).swaps; swaps != 0 {
+        t.Fatalf("expected relaxed threshold to keep the diagonal pivots, got %d swaps", swaps)
+    }
+
+    x, err := a.SolveWithPivotThreshold(b, 0.5)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, [][]float64{{1}, {2}, {3}}, x)
+
+    _, err = a.SolveWithPivotThreshold(b, 1.5)
+    if !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange for threshold above 1, got %v", err)
+    }
+}
+
+// TestLstSq tests fitting a line to noisy points and the shape checks.
+func TestLstSq(t *testing.T) {
+    // Points on y = 2x + 1 with small alternating noise
+    xs := []float64{0, 1, 2, 3, 4, 5, 6, 7}
+    noise := []float64{0.05, -0.04, 0.03, -0.05, 0.04, -0.03, 0.05, -0.04}
+
+    a, err := NewZeroMatrix(len(xs), 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    b, err := NewZeroMatrix(len(xs), 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for i, x := range xs {
+        a.Data[i][0] = x
+        a.Data[i][1] = 1
+        b.Data[i][0] = 2*x + 1 + noise[i]
+    }
+
+    fit, err := a.LstSq(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    slope, intercept := fit.Data[0][0], fit.Data[1][0]
+    if math.Abs(slope-2) > 0.05 || math.Abs(intercept-1) > 0.05 {
+        t.Fatalf("expected slope 2 and intercept 1, got %f and %f", slope, intercept)
+    }
+
+    // An exactly consistent system is recovered exactly
+    exact := Matrix{Rows: 3, Cols: 1, Data: [][]float64{{1}, {3}, {5}}}
+    fit, err = a.block(0, 3, 0, 2).LstSq(exact)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, [][]float64{{2}, {1}}, fit)
+
+    _, err = a.T().LstSq(Matrix{Rows: 2, Cols: 1, Data: [][]float64{{1}, {2}}})
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch for a wide matrix, got %v", err)
+    }
+
+    _, err = a.LstSq(exact)
+    if !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch for mismatched rows, got %v", err)
+    }
+}
@@ -0,0 +1,186 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+)
+
+// SparseMatrix stores a matrix in coordinate (COO) format: only the explicitly set entries are kept,
+// as parallel slices of row indices, column indices, and values. Every other entry is zero.
+// The entries live behind a pointer, so copies of a SparseMatrix share them, just as copies of a
+// Matrix share their rows.
+type SparseMatrix struct {
+    Rows int
+    Cols int
+
+    entries *cooEntries
+}
+
+// cooEntries holds the stored entries of a SparseMatrix.
+type cooEntries struct {
+    rowIndex []int
+    colIndex []int
+    values   []float64
+
+    // position maps (i, j) to its index in the entry slices so that Set can replace values
+    position map[[2]int]int
+}
+
+// coo returns the stored entries, or an empty set for the zero value.
+func (s SparseMatrix) coo() *cooEntries {
+    if s.entries == nil {
+        return &cooEntries{}
+    }
+    return s.entries
+}
+
+// NewSparseMatrix creates an empty rows x cols sparse matrix.
+// Returns an error if dimensions are not greater than 0.
+func NewSparseMatrix(rows, cols int) (SparseMatrix, error) {
+    if rows <= 0 || cols <= 0 {
+        return SparseMatrix{}, ErrInvalidDimensions
+    }
+    return SparseMatrix{Rows: rows, Cols: cols, entries: &cooEntries{position: make(map[[2]int]int)}}, nil
+}
+
+// Set stores value at row i and column j, replacing any value already stored there.
+// The change is visible through every copy of the sparse matrix.
+// Returns an error if the indices are out of range.
+func (s *SparseMatrix) Set(i, j int, value float64) error {
+    if i < 0 || i >= s.Rows || j < 0 || j >= s.Cols {
+        return fmt.Errorf("%w: index (%d, %d) in %d×%d sparse matrix", ErrOutOfRange, i, j, s.Rows, s.Cols)
+    }
+
+    if s.entries == nil {
+        s.entries = &cooEntries{position: make(map[[2]int]int)}
+    }
+
+    e := s.entries
+    if k, ok := e.position[[2]int{i, j}]; ok {
+        e.values[k] = value
+        return nil
+    }
+    e.position[[2]int{i, j}] = len(e.values)
+    e.rowIndex = append(e.rowIndex, i)
+    e.colIndex = append(e.colIndex, j)
+    e.values = append(e.values, value)
+    return nil
+}
+
+// NonZero returns the number of stored entries.
+func (s SparseMatrix) NonZero() int {
+    return len(s.coo().values)
+}
+
+// FromDense returns the entries of m whose magnitude exceeds tol as a sparse matrix.
+func FromDense(m Matrix, tol float64) SparseMatrix {
+    s, err := NewSparseMatrix(m.Rows, m.Cols)
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            if math.Abs(val) > tol {
+                if err := s.Set(i, j, val); err != nil {
+                    panic(err)
+                }
+            }
+        }
+    }
+
+    return s
+}
+
+// ToDense returns the sparse matrix as an ordinary Matrix.
+// Returns an error if the sparse matrix does not have valid dimensions, as for the zero value.
+func (s SparseMatrix) ToDense() (Matrix, error) {
+    if s.Rows <= 0 || s.Cols <= 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+
+    result, err := NewZeroMatrix(s.Rows, s.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    e := s.coo()
+    for k, val := range e.values {
+        result.Data[e.rowIndex[k]][e.colIndex[k]] = val
+    }
+
+    return result, nil
+}
+
+// MultiplyVector returns the product of the sparse matrix and v, visiting only the stored entries.
+// Returns an error if len(v) differs from Cols.
+func (s SparseMatrix) MultiplyVector(v []float64) ([]float64, error) {
+    if len(v) != s.Cols {
+        return nil, fmt.Errorf("%w: cannot multiply %d×%d sparse matrix by vector of length %d",
+            ErrDimensionMismatch, s.Rows, s.Cols, len(v))
+    }
+
+    result := make([]float64, s.Rows)
+    e := s.coo()
+    for k, val := range e.values {
+        result[e.rowIndex[k]] += val * v[e.colIndex[k]]
+    }
+
+    return result, nil
+}
+
+// CSRMatrix stores a matrix in compressed sparse row format. The non-zero entries of row i are
+// Values[RowStart[i]:RowStart[i+1]], in the columns given by the same range of ColIndex.
+type CSRMatrix struct {
+    Rows     int
+    Cols     int
+    RowStart []int
+    ColIndex []int
+    Values   []float64
+}
+
+// NewCSRMatrix returns the non-zero entries of m in compressed sparse row format.
+func NewCSRMatrix(m Matrix) CSRMatrix {
+    c := CSRMatrix{Rows: m.Rows, Cols: m.Cols, RowStart: make([]int, m.Rows+1)}
+
+    for i := range m.Data {
+        for j, val := range m.Data[i] {
+            if val != 0 {
+                c.ColIndex = append(c.ColIndex, j)
+                c.Values = append(c.Values, val)
+            }
+        }
+        c.RowStart[i+1] = len(c.Values)
+    }
+
+    return c
+}
+
+// Multiply returns the product of the sparse matrix and a dense matrix.
+// Each output row is accumulated from the non-zero entries of the matching sparse row only.
+// Returns an error if matrices have incompatible dimensions.
+func (c CSRMatrix) Multiply(other Matrix) (Matrix, error) {
+    if c.Cols != other.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot multiply %d×%d sparse matrix by %s: inner dimensions %d and %d differ",
+            ErrDimensionMismatch, c.Rows, c.Cols, shape(other), c.Cols, other.Rows)
+    }
+
+    result, err := NewZeroMatrix(c.Rows, other.Cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := 0; i < c.Rows; i++ {
+        out := result.Data[i]
+        for k := c.RowStart[i]; k < c.RowStart[i+1]; k++ {
+            val := c.Values[k]
+            for j, x := range other.Data[c.ColIndex[k]] {
+                out[j] += val * x
+            }
+        }
+    }
+
+    return result, nil
+}
@@ -0,0 +1,207 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+// sparseInput is a mostly-zero matrix used by the sparse format tests.
+var sparseInput = Matrix{
+    Rows: 3,
+    Cols: 4,
+    Data: [][]float64{
+        {0, 2, 0, 0},
+        {0, 0, 0, -1},
+        {5, 0, 1e-12, 3},
+    },
+}
+
+// TestSparseMatrix tests dense conversion and the sparse matrix-vector product.
+func TestSparseMatrix(t *testing.T) {
+    s := FromDense(sparseInput, 1e-9)
+    if s.NonZero() != 4 {
+        t.Fatalf("expected 4 stored entries, got %d", s.NonZero())
+    }
+
+    dense, err := s.ToDense()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := [][]float64{
+        {0, 2, 0, 0},
+        {0, 0, 0, -1},
+        {5, 0, 0, 3},
+    }
+    if !reflect.DeepEqual(dense.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, dense.Data)
+    }
+
+    v := []float64{1, 2, 3, 4}
+    got, err := s.MultiplyVector(v)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    want, err := dense.MulVec(v)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(got, want) {
+        t.Fatalf("expected %v, got %v", want, got)
+    }
+
+    if _, err := s.MultiplyVector(v[:3]); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+// TestSparseMatrixSet tests that Set replaces existing entries and rejects out-of-range indices.
+func TestSparseMatrixSet(t *testing.T) {
+    s, err := NewSparseMatrix(2, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if err := s.Set(0, 1, 4); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if err := s.Set(1, 0, -1); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    // Setting an existing entry replaces it
+    if err := s.Set(0, 1, 7); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if s.NonZero() != 2 {
+        t.Fatalf("expected 2 stored entries, got %d", s.NonZero())
+    }
+
+    dense, err := s.ToDense()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := [][]float64{
+        {0, 7},
+        {-1, 0},
+    }
+    if !reflect.DeepEqual(dense.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, dense.Data)
+    }
+
+    if err := s.Set(2, 0, 1); !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+
+    if _, err := (SparseMatrix{}).ToDense(); !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions for the zero value, got %v", err)
+    }
+}
+
+// sparseRandom returns a rows x cols random matrix with roughly one entry in every ten non-zero.
+func sparseRandom(rows, cols int) (Matrix, error) {
+    m, err := NewRandomMatrix(rows, cols, -1, 1)
+    if err != nil {
+        return Matrix{}, err
+    }
+    for i := range m.Data {
+        for j := range m.Data[i] {
+            if (i*cols+j)%10 != 0 {
+                m.Data[i][j] = 0
+            }
+        }
+    }
+    return m, nil
+}
+
+// TestSparseMatrixCopy tests that copies of a sparse matrix share their entries and stay consistent.
+func TestSparseMatrixCopy(t *testing.T) {
+    s, err := NewSparseMatrix(2, 2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if err := s.Set(0, 0, 1); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    s2 := s
+    if err := s2.Set(1, 1, 2); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if err := s.Set(1, 1, 5); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 0},
+        {0, 5},
+    }
+    for _, m := range []SparseMatrix{s, s2} {
+        if m.NonZero() != 2 {
+            t.Fatalf("expected 2 stored entries, got %d", m.NonZero())
+        }
+        dense, err := m.ToDense()
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if !reflect.DeepEqual(dense.Data, expected) {
+            t.Fatalf("expected %v, got %v", expected, dense.Data)
+        }
+    }
+
+    // A literal with a shape but no entries is usable too
+    literal := SparseMatrix{Rows: 1, Cols: 2}
+    if err := literal.Set(0, 1, 3); err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if got, err := literal.MultiplyVector([]float64{1, 1}); err != nil || got[0] != 3 {
+        t.Fatalf("expected [3], got %v (err %v)", got, err)
+    }
+}
+
+// TestCSRMatrixMultiply tests the CSR product against the dense one on a mostly-zero matrix.
+func TestCSRMatrixMultiply(t *testing.T) {
+    a, err := sparseRandom(20, 15)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    b, err := NewRandomMatrix(15, 7, -1, 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    c := NewCSRMatrix(a)
+    if len(c.Values) != 30 {
+        t.Fatalf("expected 30 stored entries, got %d", len(c.Values))
+    }
+
+    got, err := c.Multiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected, err := a.Multiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, expected.Data, got)
+
+    if _, err := c.Multiply(a); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+}
+
+func BenchmarkCSRMultiply(b *testing.B) {
+    a, err := sparseRandom(256, 256)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+    other, err := NewRandomMatrix(256, 256, -1, 1)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+    c := NewCSRMatrix(a)
+
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        _, _ = c.Multiply(other)
+    }
+}
@@ -0,0 +1,207 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// sum returns the sum of values.
+func sum(values []float64) float64 {
+    total := 0.0
+    for _, v := range values {
+        total += v
+    }
+    return total
+}
+
+// mean returns the arithmetic mean of values, which must not be empty.
+func mean(values []float64) float64 {
+    return sum(values) / float64(len(values))
+}
+
+// minimum returns the smallest of values, which must not be empty.
+func minimum(values []float64) float64 {
+    result := values[0]
+    for _, v := range values[1:] {
+        if v < result {
+            result = v
+        }
+    }
+    return result
+}
+
+// maximum returns the largest of values, which must not be empty.
+func maximum(values []float64) float64 {
+    result := values[0]
+    for _, v := range values[1:] {
+        if v > result {
+            result = v
+        }
+    }
+    return result
+}
+
+// reduceAxis collapses the matrix along an axis by applying reduce to each column (axis 0) or row (axis 1).
+// Axis 0 produces a 1 x Cols matrix and axis 1 produces a Rows x 1 matrix.
+// Returns an error for any other axis.
+func (m Matrix) reduceAxis(axis int, reduce func([]float64) float64) (Matrix, error) {
+    switch axis {
+    case 0:
+        result, err := NewZeroMatrix(1, m.Cols)
+        if err != nil {
+            panic(err)
+        }
+        column := make([]float64, m.Rows)
+        for j := 0; j < m.Cols; j++ {
+            for i := range m.Data {
+                column[i] = m.Data[i][j]
+            }
+            result.Data[0][j] = reduce(column)
+        }
+        return result, nil
+    case 1:
+        result, err := NewZeroMatrix(m.Rows, 1)
+        if err != nil {
+            panic(err)
+        }
+        for i, row := range m.Data {
+            result.Data[i][0] = reduce(row)
+        }
+        return result, nil
+    default:
+        return Matrix{}, fmt.Errorf("%w: axis must be 0 or 1, got %d", ErrOutOfRange, axis)
+    }
+}
+
+// SumAxis returns the sum of each column (axis 0) or each row (axis 1).
+// Returns an error for any axis other than 0 or 1.
+func (m Matrix) SumAxis(axis int) (Matrix, error) {
+    return m.reduceAxis(axis, sum)
+}
+
+// MeanAxis returns the mean of each column (axis 0) or each row (axis 1).
+// Returns an error for any axis other than 0 or 1.
+func (m Matrix) MeanAxis(axis int) (Matrix, error) {
+    return m.reduceAxis(axis, mean)
+}
+
+// MinAxis returns the minimum of each column (axis 0) or each row (axis 1).
+// Returns an error for any axis other than 0 or 1.
+func (m Matrix) MinAxis(axis int) (Matrix, error) {
+    return m.reduceAxis(axis, minimum)
+}
+
+// MaxAxis returns the maximum of each column (axis 0) or each row (axis 1).
+// Returns an error for any axis other than 0 or 1.
+func (m Matrix) MaxAxis(axis int) (Matrix, error) {
+    return m.reduceAxis(axis, maximum)
+}
+
+// Sum returns the sum of all elements.
+func (m Matrix) Sum() float64 {
+    total := 0.0
+    m.eachRun(func(run []float64) {
+        total += sum(run)
+    })
+    return total
+}
+
+// Mean returns the mean of all elements.
+func (m Matrix) Mean() float64 {
+    return m.Sum() / float64(m.Rows*m.Cols)
+}
+
+// Min returns the smallest element.
+func (m Matrix) Min() float64 {
+    result, first := 0.0, true
+    m.eachRun(func(run []float64) {
+        if v := minimum(run); first || v < result {
+            result, first = v, false
+        }
+    })
+    return result
+}
+
+// Max returns the largest element.
+func (m Matrix) Max() float64 {
+    result, first := 0.0, true
+    m.eachRun(func(run []float64) {
+        if v := maximum(run); first || v > result {
+            result, first = v, false
+        }
+    })
+    return result
+}
+
+// centerColumns returns a copy of the matrix with each column's mean subtracted from it.
+func (m Matrix) centerColumns() Matrix {
+    means, err := m.MeanAxis(0)
+    if err != nil {
+        panic(err)
+    }
+
+    centered := m.clone()
+    for _, row := range centered.Data {
+        for j := range row {
+            row[j] -= means.Data[0][j]
+        }
+    }
+    return centered
+}
+
+// Covariance returns the Cols×Cols sample covariance matrix, treating each row as an observation and
+// each column as a variable. Deviations are taken from the column means and normalized by n-1.
+// Returns an error if there are fewer than two observations.
+func (m Matrix) Covariance() (Matrix, error) {
+    if m.Rows < 2 {
+        return Matrix{}, fmt.Errorf("%w: Covariance requires at least two observations, got %d", ErrDimensionMismatch, m.Rows)
+    }
+
+    result := m.centerColumns().gram()
+    scale := 1 / float64(m.Rows-1)
+    result.ApplyInPlace(func(x float64) float64 { return x * scale })
+
+    return result, nil
+}
+
+// PCA performs principal component analysis, treating each row as an observation and each column as a feature.
+// The data is centered and projected onto the eigenvectors of its covariance matrix with the largest
+// eigenvalues. projected has one row per observation and one column per component, in decreasing order
+// of variance, and explainedVariance holds the variance of the data along each of those components.
+// The sign of each component is arbitrary.
+// Returns an error if components is less than 1 or more than the number of features, if there are fewer
+// than two observations, or if the eigen-decomposition does not converge.
+func (m Matrix) PCA(components int) (projected Matrix, explainedVariance []float64, err error) {
+    if components < 1 || components > m.Cols {
+        return Matrix{}, nil, fmt.Errorf("%w: cannot take %d components of %d features", ErrOutOfRange, components, m.Cols)
+    }
+
+    cov, err := m.Covariance()
+    if err != nil {
+        return Matrix{}, nil, err
+    }
+    values, vectors, err := cov.EigenSymmetric(1e-14*(1+cov.FrobeniusNorm()), jacobiMaxSweeps)
+    if err != nil {
+        return Matrix{}, nil, err
+    }
+
+    // EigenSymmetric sorts ascending, so the top components are the last columns
+    basis, err := NewZeroMatrix(m.Cols, components)
+    if err != nil {
+        panic(err)
+    }
+    explainedVariance = make([]float64, components)
+    for k := range explainedVariance {
+        source := m.Cols - 1 - k
+        explainedVariance[k] = values[source]
+        for i := range basis.Data {
+            basis.Data[i][k] = vectors.Data[i][source]
+        }
+    }
+
+    projected, err = m.centerColumns().Multiply(basis)
+    if err != nil {
+        panic(err)
+    }
+
+    return projected, explainedVariance, nil
+}
@@ -0,0 +1,173 @@
+package matrix
+
+import (
+    "math"
+    "reflect"
+    "testing"
+)
+
+var axisInput = Matrix{
+    Rows: 2,
+    Cols: 3,
+    Data: [][]float64{
+        {1, -2, 6},
+        {3, 4, 0},
+    },
+}
+
+// assertAxis checks both axes of a reduction along with the invalid-axis error.
+func assertAxis(t *testing.T, reduce func(int) (Matrix, error), cols []float64, rows []float64) {
+    t.Helper()
+
+    result, err := reduce(0)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if !reflect.DeepEqual(result.Data, [][]float64{cols}) {
+        t.Fatalf("expected %v, got %v", [][]float64{cols}, result.Data)
+    }
+
+    result, err = reduce(1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := [][]float64{{rows[0]}, {rows[1]}}
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    _, err = reduce(2)
+    if err == nil {
+        t.Fatal("expected error for invalid axis, but got none")
+    }
+}
+
+func TestSumAxis(t *testing.T) {
+    assertAxis(t, axisInput.SumAxis, []float64{4, 2, 6}, []float64{5, 7})
+}
+
+func TestMeanAxis(t *testing.T) {
+    assertAxis(t, axisInput.MeanAxis, []float64{2, 1, 3}, []float64{5.0 / 3, 7.0 / 3})
+}
+
+func TestMinAxis(t *testing.T) {
+    assertAxis(t, axisInput.MinAxis, []float64{1, -2, 0}, []float64{-2, 0})
+}
+
+func TestMaxAxis(t *testing.T) {
+    assertAxis(t, axisInput.MaxAxis, []float64{3, 4, 6}, []float64{6, 4})
+}
+
+func TestSum(t *testing.T) {
+    if total := axisInput.Sum(); total != 12 {
+        t.Fatalf("expected sum 12, got %f", total)
+    }
+}
+
+func TestMean(t *testing.T) {
+    expected := axisInput.Sum() / float64(axisInput.Rows*axisInput.Cols)
+    if mean := axisInput.Mean(); mean != expected {
+        t.Fatalf("expected mean %f, got %f", expected, mean)
+    }
+}
+
+func TestMinMax(t *testing.T) {
+    if min := axisInput.Min(); min != -2 {
+        t.Fatalf("expected min -2, got %f", min)
+    }
+    if max := axisInput.Max(); max != 6 {
+        t.Fatalf("expected max 6, got %f", max)
+    }
+
+    single := Matrix{
+        Rows: 1,
+        Cols: 1,
+        Data: [][]float64{
+            {-7},
+        },
+    }
+    if single.Min() != -7 || single.Max() != -7 {
+        t.Fatalf("expected min and max -7, got %f and %f", single.Min(), single.Max())
+    }
+}
+
+func TestCovariance(t *testing.T) {
+    // Column means are 2, 4 and 1
+    data := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 1},
+            {2, 4, 0},
+            {3, 6, 2},
+        },
+    }
+
+    cov, err := data.Covariance()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    // Deviations are (-1, 0, 1), (-2, 0, 2) and (0, -1, 1), divided by n-1 = 2
+    expected := [][]float64{
+        {1, 2, 0.5},
+        {2, 4, 1},
+        {0.5, 1, 1},
+    }
+    assertClose(t, expected, cov)
+
+    _, err = Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}.Covariance()
+    if err == nil {
+        t.Fatal("expected error for a single observation, but got none")
+    }
+}
+
+func TestPCA(t *testing.T) {
+    // Points spread along the direction (1, 1) with small offsets across it
+    data, err := NewMatrixFromFunc(20, 2, func(i, j int) float64 {
+        along := float64(i) - 9.5
+        across := 0.1 * float64(i%3-1)
+        if j == 0 {
+            return along + across
+        }
+        return along - across
+    })
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    projected, explained, err := data.PCA(2)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if projected.Rows != 20 || projected.Cols != 2 {
+        t.Fatalf("expected a 20x2 projection, got %dx%d", projected.Rows, projected.Cols)
+    }
+    if explained[0] < explained[1] {
+        t.Fatalf("expected decreasing explained variance, got %v", explained)
+    }
+    if ratio := explained[0] / (explained[0] + explained[1]); ratio < 0.99 {
+        t.Fatalf("expected the first component to explain over 99%% of the variance, got %f", ratio)
+    }
+
+    // Projecting onto all components preserves the total variance
+    cov, err := data.Covariance()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if total := cov.Data[0][0] + cov.Data[1][1]; math.Abs(total-explained[0]-explained[1]) > 1e-9 {
+        t.Fatalf("expected explained variance to sum to %f, got %v", total, explained)
+    }
+
+    projected, explained, err = data.PCA(1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if projected.Cols != 1 || len(explained) != 1 {
+        t.Fatalf("expected one component, got %d columns and %d variances", projected.Cols, len(explained))
+    }
+
+    if _, _, err := data.PCA(3); err == nil {
+        t.Fatal("expected error for more components than features, but got none")
+    }
+}
@@ -0,0 +1,122 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// StrassenCutoff is the size at or below which StrassenMultiply stops recursing and multiplies
+// the sub-matrices with the standard algorithm.
+var StrassenCutoff = 64
+
+// squareData allocates an n x n block of zeros.
+func squareData(n int) [][]float64 {
+    data := make([][]float64, n)
+    for i := range data {
+        data[i] = make([]float64, n)
+    }
+    return data
+}
+
+// addData returns a+b for equally sized square blocks.
+func addData(a, b [][]float64) [][]float64 {
+    result := squareData(len(a))
+    for i := range a {
+        for j := range a[i] {
+            result[i][j] = a[i][j] + b[i][j]
+        }
+    }
+    return result
+}
+
+// subData returns a-b for equally sized square blocks.
+func subData(a, b [][]float64) [][]float64 {
+    result := squareData(len(a))
+    for i := range a {
+        for j := range a[i] {
+            result[i][j] = a[i][j] - b[i][j]
+        }
+    }
+    return result
+}
+
+// quadrants splits a square block of even size into its four half-size quadrants.
+// The quadrants share storage with the original block.
+func quadrants(a [][]float64) (a11, a12, a21, a22 [][]float64) {
+    h := len(a) / 2
+    a11, a12 = make([][]float64, h), make([][]float64, h)
+    a21, a22 = make([][]float64, h), make([][]float64, h)
+    for i := 0; i < h; i++ {
+        a11[i], a12[i] = a[i][:h], a[i][h:]
+        a21[i], a22[i] = a[h+i][:h], a[h+i][h:]
+    }
+    return a11, a12, a21, a22
+}
+
+// strassen multiplies two square blocks whose size is a power of two.
+func strassen(a, b [][]float64) [][]float64 {
+    n := len(a)
+    if n <= StrassenCutoff {
+        result := squareData(n)
+        multiplyBlocked(result, a, b, MultiplyBlockSize)
+        return result
+    }
+
+    a11, a12, a21, a22 := quadrants(a)
+    b11, b12, b21, b22 := quadrants(b)
+
+    m1 := strassen(addData(a11, a22), addData(b11, b22))
+    m2 := strassen(addData(a21, a22), b11)
+    m3 := strassen(a11, subData(b12, b22))
+    m4 := strassen(a22, subData(b21, b11))
+    m5 := strassen(addData(a11, a12), b22)
+    m6 := strassen(subData(a21, a11), addData(b11, b12))
+    m7 := strassen(subData(a12, a22), addData(b21, b22))
+
+    result := squareData(n)
+    h := n / 2
+    for i := 0; i < h; i++ {
+        for j := 0; j < h; j++ {
+            result[i][j] = m1[i][j] + m4[i][j] - m5[i][j] + m7[i][j]
+            result[i][j+h] = m3[i][j] + m5[i][j]
+            result[i+h][j] = m2[i][j] + m4[i][j]
+            result[i+h][j+h] = m1[i][j] - m2[i][j] + m3[i][j] + m6[i][j]
+        }
+    }
+
+    return result
+}
+
+// padData copies a matrix into the top-left corner of an n x n block of zeros.
+func padData(m Matrix, n int) [][]float64 {
+    data := squareData(n)
+    for i := range m.Data {
+        copy(data[i], m.Data[i])
+    }
+    return data
+}
+
+// StrassenMultiply performs matrix multiplication using Strassen's algorithm.
+// Both operands are padded with zeros to a common power-of-two size, and the recursion falls back
+// to the standard algorithm once blocks reach StrassenCutoff. The result matches Multiply up to rounding,
+// but Strassen's additional additions can make that rounding slightly larger.
+// Returns an error if matrices have incompatible dimensions.
+func (m Matrix) StrassenMultiply(other Matrix) (Matrix, error) {
+    if m.Cols != other.Rows {
+        return Matrix{}, fmt.Errorf("%w: cannot multiply %s by %s: inner dimensions %d and %d differ",
+            ErrDimensionMismatch, shape(m), shape(other), m.Cols, other.Rows)
+    }
+
+    size := 1
+    for size < m.Rows || size < m.Cols || size < other.Cols {
+        size *= 2
+    }
+
+    product := strassen(padData(m, size), padData(other, size))
+
+    data := make([][]float64, m.Rows)
+    for i := range data {
+        data[i] = product[i][:other.Cols:other.Cols]
+    }
+
+    return Matrix{Rows: m.Rows, Cols: other.Cols, Data: data}, nil
+}
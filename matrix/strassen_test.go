@@ -0,0 +1,73 @@
+package matrix
+
+import (
+    "testing"
+)
+
+// TestStrassenMultiply tests Strassen multiplication against Multiply on a 128x128 matrix.
+func TestStrassenMultiply(t *testing.T) {
+    a, err := NewRandomMatrix(128, 128, -1, 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    b, err := NewRandomMatrix(128, 128, -1, 1)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    original := StrassenCutoff
+    StrassenCutoff = 16
+    defer func() { StrassenCutoff = original }()
+
+    expected, err := a.Multiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    result, err := a.StrassenMultiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    assertClose(t, expected.Data, result)
+}
+
+// TestStrassenMultiplyRectangular tests that padding handles rectangular operands.
+func TestStrassenMultiplyRectangular(t *testing.T) {
+    a := integerMatrix(5, 7)
+    b := integerMatrix(7, 3)
+
+    original := StrassenCutoff
+    StrassenCutoff = 1
+    defer func() { StrassenCutoff = original }()
+
+    expected, err := a.Multiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    result, err := a.StrassenMultiply(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if result.Rows != 5 || result.Cols != 3 {
+        t.Fatalf("expected dimensions (5, 3), got (%d, %d)", result.Rows, result.Cols)
+    }
+    assertClose(t, expected.Data, result)
+
+    _, err = a.StrassenMultiply(a)
+    if err == nil {
+        t.Fatal("expected error for matrices with incompatible dimensions, but got none")
+    }
+}
+
+func BenchmarkStrassenMultiply512(b *testing.B) {
+    x, err := NewRandomMatrix(512, 512, -1, 1)
+    if err != nil {
+        b.Fatalf("unexpected error: %v", err)
+    }
+
+    b.ResetTimer()
+    for i := 0; i < b.N; i++ {
+        _, _ = x.StrassenMultiply(x)
+    }
+}
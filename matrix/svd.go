@@ -0,0 +1,185 @@
+package matrix
+
+import (
+    "math"
+    "sort"
+)
+
+// gram returns AᵀA, built so that it is exactly symmetric.
+func (m Matrix) gram() Matrix {
+    g, err := NewZeroMatrix(m.Cols, m.Cols)
+    if err != nil {
+        panic(err)
+    }
+    for i := 0; i < m.Cols; i++ {
+        for j := i; j < m.Cols; j++ {
+            total := 0.0
+            for k := 0; k < m.Rows; k++ {
+                total += m.Data[k][i] * m.Data[k][j]
+            }
+            g.Data[i][j] = total
+            g.Data[j][i] = total
+        }
+    }
+    return g
+}
+
+// SVD computes the thin singular value decomposition A = U S Vᵀ.
+// For an m×n matrix with k = min(m, n), U is m×k, S is a k×k diagonal matrix of singular values
+// in descending order, and V is n×k. The columns of U and V are orthonormal.
+// V comes from the eigen-decomposition of AᵀA (or AAᵀ for wide matrices) and each singular value is
+// the norm of A times the matching column of V, so small singular values are kept rather than rounded away.
+// Returns an error if the eigen-decomposition does not converge.
+func (m Matrix) SVD() (U, S, V Matrix, err error) {
+    if m.Rows < m.Cols {
+        // Decompose the transpose and swap the factors: A = (V' S U'ᵀ)ᵀ = U' S V'ᵀ
+        V, S, U, err = m.T().SVD()
+        return U, S, V, err
+    }
+
+    g := m.gram()
+    _, vectors, err := g.EigenSymmetric(1e-14*(1+g.FrobeniusNorm()), jacobiMaxSweeps)
+    if err != nil {
+        return Matrix{}, Matrix{}, Matrix{}, err
+    }
+
+    // Each singular value is taken as the norm of A v rather than the square root of an eigenvalue of AᵀA.
+    // Rounding in AᵀA is on the order of machine epsilon times σmax², so its square root would turn exact
+    // zeros into spurious values near 1e-8 σmax, while A v keeps the accuracy of the eigenvector
+    k := m.Cols
+    images := make([][]float64, k)
+    norms := make([]float64, k)
+    for j := 0; j < k; j++ {
+        images[j] = make([]float64, m.Rows)
+        for i := range m.Data {
+            for l := range m.Data[i] {
+                images[j][i] += m.Data[i][l] * vectors.Data[l][j]
+            }
+        }
+        norms[j] = math.Sqrt(sumOfSquares(images[j]))
+    }
+
+    // EigenSymmetric sorts ascending, so start from the reverse and sort by the norms for descending singular values
+    order := make([]int, k)
+    for i := range order {
+        order[i] = k - 1 - i
+    }
+    sort.SliceStable(order, func(a, b int) bool { return norms[order[a]] > norms[order[b]] })
+
+    sigma := make([]float64, k)
+    for i, j := range order {
+        sigma[i] = norms[j]
+    }
+    V, err = vectors.PermuteColumns(order)
+    if err != nil {
+        panic(err)
+    }
+
+    U, err = NewZeroMatrix(m.Rows, k)
+    if err != nil {
+        panic(err)
+    }
+
+    // Each column of U is A v orthogonalized against the earlier columns, twice for stability, then normalized.
+    // Zero singular values, and images that all but vanish under orthogonalization and so are rounding noise
+    // in the span of the earlier columns, get an arbitrary column of U that keeps the columns orthonormal
+    for j := 0; j < k; j++ {
+        column := make([]float64, m.Rows)
+        copy(column, images[order[j]])
+        for pass := 0; pass < 2; pass++ {
+            for c := 0; c < j; c++ {
+                proj := 0.0
+                for i := range column {
+                    proj += U.Data[i][c] * column[i]
+                }
+                for i := range column {
+                    column[i] -= proj * U.Data[i][c]
+                }
+            }
+        }
+        if norm := math.Sqrt(sumOfSquares(column)); norm > norms[order[j]]*1e-8 {
+            for i := range column {
+                column[i] /= norm
+            }
+        } else {
+            column = U.orthogonalComplement(j)
+        }
+        for i := range column {
+            U.Data[i][j] = column[i]
+        }
+    }
+
+    return U, NewDiagonalMatrix(sigma), V, nil
+}
+
+// orthogonalComplement returns a unit vector orthogonal to the first j columns of m, which must be orthonormal.
+// Standard basis vectors are tried in turn and the one with the largest remainder after Gram-Schmidt is kept.
+func (m Matrix) orthogonalComplement(j int) []float64 {
+    var best []float64
+    bestNorm := -1.0
+    for e := 0; e < m.Rows; e++ {
+        candidate := make([]float64, m.Rows)
+        candidate[e] = 1
+        for c := 0; c < j; c++ {
+            proj := m.Data[e][c]
+            for i := range candidate {
+                candidate[i] -= proj * m.Data[i][c]
+            }
+        }
+        if norm := math.Sqrt(sumOfSquares(candidate)); norm > bestNorm {
+            best, bestNorm = candidate, norm
+        }
+    }
+    normalize(best)
+    return best
+}
+
+// Pinv returns the Moore-Penrose pseudoinverse of the matrix, computed from its SVD as V S⁺ Uᵀ.
+// Singular values at or below tol are treated as zero, so rank-deficient matrices are supported.
+// The result is n×m for an m×n matrix.
+// Returns an error if the SVD does not converge.
+func (m Matrix) Pinv(tol float64) (Matrix, error) {
+    U, S, V, err := m.SVD()
+    if err != nil {
+        return Matrix{}, err
+    }
+
+    result, err := NewZeroMatrix(m.Cols, m.Rows)
+    if err != nil {
+        panic(err)
+    }
+
+    for k, sigma := range S.Diagonal() {
+        if sigma <= tol {
+            continue
+        }
+        for i := range result.Data {
+            scaled := V.Data[i][k] / sigma
+            for j := range result.Data[i] {
+                result.Data[i][j] += scaled * U.Data[j][k]
+            }
+        }
+    }
+
+    return result, nil
+}
+
+// RankSVD returns the numerical rank, the number of singular values greater than tol*σmax, where σmax is
+// the largest singular value. The tolerance is relative, so scaling the matrix does not change its rank.
+// This is more reliable than Rank near singularity.
+// If the SVD does not converge, the elimination-based Rank is returned instead.
+func (m Matrix) RankSVD(tol float64) int {
+    _, S, _, err := m.SVD()
+    if err != nil {
+        return m.Rank()
+    }
+
+    sigma := S.Diagonal()
+    rank := 0
+    for _, s := range sigma {
+        if s > tol*sigma[0] {
+            rank++
+        }
+    }
+    return rank
+}
@@ -0,0 +1,210 @@
+package matrix
+
+import (
+    "math"
+    "testing"
+)
+
+// reconstruct returns U S Vᵀ.
+func reconstruct(t *testing.T, U, S, V Matrix) Matrix {
+    us, err := U.Multiply(S)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    result, err := us.Multiply(V.T())
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    return result
+}
+
+// assertOrthonormalColumns checks that QᵀQ is the identity.
+func assertOrthonormalColumns(t *testing.T, q Matrix) {
+    qtq, err := q.T().Multiply(q)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    identity, err := NewIdentityMatrix(q.Cols)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, identity.Data, qtq)
+}
+
+// TestSVD tests that tall, wide, and rank-deficient matrices are reconstructed from their SVD.
+func TestSVD(t *testing.T) {
+    tests := []Matrix{
+        {
+            Rows: 3,
+            Cols: 2,
+            Data: [][]float64{
+                {3, 2},
+                {2, 3},
+                {2, -2},
+            },
+        },
+        {
+            Rows: 2,
+            Cols: 3,
+            Data: [][]float64{
+                {3, 2, 2},
+                {2, 3, -2},
+            },
+        },
+        {
+            Rows: 3,
+            Cols: 3,
+            Data: [][]float64{
+                {1, 2, 3},
+                {2, 4, 6},
+                {1, 0, 1},
+            },
+        },
+    }
+
+    for _, m := range tests {
+        U, S, V, err := m.SVD()
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+
+        k := m.Rows
+        if m.Cols < k {
+            k = m.Cols
+        }
+        if U.Rows != m.Rows || U.Cols != k || S.Rows != k || S.Cols != k || V.Rows != m.Cols || V.Cols != k {
+            t.Fatalf("unexpected shapes U %dx%d, S %dx%d, V %dx%d for %dx%d input",
+                U.Rows, U.Cols, S.Rows, S.Cols, V.Rows, V.Cols, m.Rows, m.Cols)
+        }
+
+        assertClose(t, m.Data, reconstruct(t, U, S, V))
+        assertOrthonormalColumns(t, U)
+        assertOrthonormalColumns(t, V)
+
+        sigma := S.Diagonal()
+        for i := 1; i < len(sigma); i++ {
+            if sigma[i] > sigma[i-1] {
+                t.Fatalf("expected descending singular values, got %v", sigma)
+            }
+        }
+    }
+
+    // The first two test matrices have singular values 5 and 3
+    _, S, _, err := tests[0].SVD()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if sigma := S.Diagonal(); math.Abs(sigma[0]-5) > 1e-9 || math.Abs(sigma[1]-3) > 1e-9 {
+        t.Fatalf("expected singular values [5 3], got %v", sigma)
+    }
+}
+
+// TestPinv tests the Penrose conditions A Pinv(A) A = A and Pinv(A) A Pinv(A) = Pinv(A) for a rank-deficient non-square matrix.
+func TestPinv(t *testing.T) {
+    // The third row is the sum of the first two, so the rank is 2
+    a := Matrix{
+        Rows: 3,
+        Cols: 4,
+        Data: [][]float64{
+            {1, 2, 0, -1},
+            {0, 1, 3, 2},
+            {1, 3, 3, 1},
+        },
+    }
+
+    pinv, err := a.Pinv(1e-9)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if pinv.Rows != 4 || pinv.Cols != 3 {
+        t.Fatalf("expected a 4x3 pseudoinverse, got %dx%d", pinv.Rows, pinv.Cols)
+    }
+
+    ap, err := a.Multiply(pinv)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    apa, err := ap.Multiply(a)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, a.Data, apa)
+
+    pap, err := pinv.Multiply(ap)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, pinv.Data, pap)
+
+    // A full-rank square matrix has its ordinary inverse as pseudoinverse
+    b := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {4, 7},
+            {2, 6},
+        },
+    }
+    pinv, err = b.Pinv(1e-9)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    inverse, err := b.Inverse()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    assertClose(t, inverse.Data, pinv)
+}
+
+// TestRankSVD tests a 3x3 matrix whose smallest singular value is 1e-4 times the largest.
+func TestRankSVD(t *testing.T) {
+    // A = QD with Q an orthogonal reflection, so the singular values are the diagonal of D
+    v := []float64{1, 2, 2}
+    q := Outer(v, v)
+    q.ApplyInPlace(func(x float64) float64 { return -2 * x / 9 })
+    for i := range q.Data {
+        q.Data[i][i]++
+    }
+    a, err := q.Multiply(NewDiagonalMatrix([]float64{2, 1, 2e-4}))
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if rank := a.RankSVD(1e-3); rank != 2 {
+        t.Fatalf("expected rank 2 with tolerance 1e-3, got %d", rank)
+    }
+    if rank := a.RankSVD(1e-5); rank != 3 {
+        t.Fatalf("expected rank 3 with tolerance 1e-5, got %d", rank)
+    }
+
+    // The tolerance is relative to the largest singular value
+    a.ApplyInPlace(func(x float64) float64 { return x * 1e6 })
+    if rank := a.RankSVD(1e-3); rank != 2 {
+        t.Fatalf("expected rank 2 after scaling, got %d", rank)
+    }
+}
+
+// TestSVDSmallSingularValue tests that a singular value far below the largest is kept rather than zeroed.
+func TestSVDSmallSingularValue(t *testing.T) {
+    a := NewDiagonalMatrix([]float64{1, 1e-7})
+
+    _, S, _, err := a.SVD()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if sigma := S.Diagonal(); math.Abs(sigma[0]-1) > 1e-12 || math.Abs(sigma[1]-1e-7) > 1e-18 {
+        t.Fatalf("expected singular values [1 1e-07], got %v", sigma)
+    }
+
+    if rank := a.RankSVD(1e-9); rank != 2 {
+        t.Fatalf("expected rank 2, got %d", rank)
+    }
+
+    pinv, err := a.Pinv(1e-9)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if math.Abs(pinv.Data[1][1]-1e7) > 1e-3 {
+        t.Fatalf("expected pseudoinverse entry 1e7, got %v", pinv.Data[1][1])
+    }
+}
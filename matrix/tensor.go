@@ -0,0 +1,102 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// validateTensor checks that dims describes a tensor with count elements and that mode is one of its axes.
+func validateTensor(mode int, dims []int, count int) error {
+    if len(dims) == 0 {
+        return fmt.Errorf("%w: tensor needs at least one dimension", ErrInvalidDimensions)
+    }
+    size := 1
+    for _, d := range dims {
+        if d <= 0 {
+            return ErrInvalidDimensions
+        }
+        size *= d
+    }
+    if size != count {
+        return fmt.Errorf("%w: tensor dimensions %v hold %d elements, matrix has %d", ErrDimensionMismatch, dims, size, count)
+    }
+    if mode < 0 || mode >= len(dims) {
+        return fmt.Errorf("%w: mode %d for a tensor of order %d", ErrOutOfRange, mode, len(dims))
+    }
+    return nil
+}
+
+// unfoldIndex maps the row-major position k of a tensor element to its row and column in the mode-n unfolding.
+func unfoldIndex(k, mode int, dims []int) (row, col int) {
+    stride := 1
+    for axis := len(dims) - 1; axis >= 0; axis-- {
+        index := k % dims[axis]
+        k /= dims[axis]
+        if axis == mode {
+            row = index
+            continue
+        }
+        col += index * stride
+        stride *= dims[axis]
+    }
+    return row, col
+}
+
+// Unfold treats the elements of the matrix, read in row-major order, as a tensor with the given dimensions
+// (last index varying fastest) and returns its mode-n matricization.
+// The result has dims[mode] rows, and each column is a mode-n fiber: the elements obtained by varying
+// index mode while the others stay fixed. Columns are ordered by the remaining indices in row-major order,
+// matching NumPy's moveaxis(t, mode, 0).reshape(dims[mode], -1).
+// Returns an error if the dimensions are not positive, do not multiply to the number of elements,
+// or mode is not a valid axis.
+func (m Matrix) Unfold(mode int, dims []int) (Matrix, error) {
+    if err := validateTensor(mode, dims, m.Rows*m.Cols); err != nil {
+        return Matrix{}, err
+    }
+
+    result, err := NewZeroMatrix(dims[mode], m.Rows*m.Cols/dims[mode])
+
+    if err != nil {
+        panic(err)
+    }
+
+    k := 0
+    m.eachRun(func(run []float64) {
+        for _, val := range run {
+            row, col := unfoldIndex(k, mode, dims)
+            result.Data[row][col] = val
+            k++
+        }
+    })
+
+    return result, nil
+}
+
+// Fold is the inverse of Unfold. It reads the receiver as the mode-n unfolding of a tensor with the given
+// dimensions and returns that tensor's elements, in row-major order, as a rows×cols matrix.
+// Returns an error if the dimensions are not positive, mode is not a valid axis, the receiver is not
+// dims[mode]×(remaining elements), or rows×cols does not hold the same number of elements.
+func (m Matrix) Fold(mode int, dims []int, rows, cols int) (Matrix, error) {
+    if rows <= 0 || cols <= 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+    if err := validateTensor(mode, dims, rows*cols); err != nil {
+        return Matrix{}, err
+    }
+    if m.Rows != dims[mode] || m.Rows*m.Cols != rows*cols {
+        return Matrix{}, fmt.Errorf("%w: cannot fold %s as mode-%d unfolding of tensor %v",
+            ErrDimensionMismatch, shape(m), mode, dims)
+    }
+
+    result, err := NewZeroMatrix(rows, cols)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for k := 0; k < rows*cols; k++ {
+        row, col := unfoldIndex(k, mode, dims)
+        result.Data[k/cols][k%cols] = m.Data[row][col]
+    }
+
+    return result, nil
+}
@@ -0,0 +1,75 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+// TestUnfold tests the mode-0 and mode-1 unfoldings of a 2x3x2 tensor stored as a 3x4 matrix.
+func TestUnfold(t *testing.T) {
+    // Element (i, j, k) is 100i + 10j + k, stored in row-major order
+    a := Matrix{
+        Rows: 3,
+        Cols: 4,
+        Data: [][]float64{
+            {0, 1, 10, 11},
+            {20, 21, 100, 101},
+            {110, 111, 120, 121},
+        },
+    }
+    dims := []int{2, 3, 2}
+
+    tests := []struct {
+        mode     int
+        expected [][]float64
+    }{
+        {
+            mode: 0,
+            expected: [][]float64{
+                {0, 1, 10, 11, 20, 21},
+                {100, 101, 110, 111, 120, 121},
+            },
+        },
+        {
+            mode: 1,
+            expected: [][]float64{
+                {0, 1, 100, 101},
+                {10, 11, 110, 111},
+                {20, 21, 120, 121},
+            },
+        },
+        {
+            mode: 2,
+            expected: [][]float64{
+                {0, 10, 20, 100, 110, 120},
+                {1, 11, 21, 101, 111, 121},
+            },
+        },
+    }
+
+    for _, test := range tests {
+        unfolded, err := a.Unfold(test.mode, dims)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if !reflect.DeepEqual(unfolded.Data, test.expected) {
+            t.Fatalf("mode %d: expected %v, got %v", test.mode, test.expected, unfolded.Data)
+        }
+
+        folded, err := unfolded.Fold(test.mode, dims, a.Rows, a.Cols)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if !reflect.DeepEqual(folded.Data, a.Data) {
+            t.Fatalf("mode %d: expected fold to restore %v, got %v", test.mode, a.Data, folded.Data)
+        }
+    }
+
+    if _, err := a.Unfold(0, []int{2, 2, 2}); !errors.Is(err, ErrDimensionMismatch) {
+        t.Fatalf("expected ErrDimensionMismatch, got %v", err)
+    }
+    if _, err := a.Unfold(3, dims); !errors.Is(err, ErrOutOfRange) {
+        t.Fatalf("expected ErrOutOfRange, got %v", err)
+    }
+}
@@ -0,0 +1,63 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// NewToeplitzMatrix creates a Toeplitz matrix, which is constant along each diagonal.
+// column gives the first column and row gives the first row, so element (i, j) is column[i-j]
+// on or below the diagonal and row[j-i] above it. The first elements of column and row must match.
+func NewToeplitzMatrix(column, row []float64) (Matrix, error) {
+    if len(column) == 0 || len(row) == 0 {
+        return Matrix{}, ErrInvalidDimensions
+    }
+    if column[0] != row[0] {
+        return Matrix{}, fmt.Errorf("%w: first column and row must share their first element, got %g and %g", ErrInvalidValue, column[0], row[0])
+    }
+
+    result, err := NewZeroMatrix(len(column), len(row))
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range result.Data {
+        for j := range result.Data[i] {
+            if i >= j {
+                result.Data[i][j] = column[i-j]
+            } else {
+                result.Data[i][j] = row[j-i]
+            }
+        }
+    }
+
+    return result, nil
+}
+
+// Conv1DAsMatrix expresses full 1-D convolution with kernel as a linear operator.
+// It returns the (Cols+len(kernel)-1) x Cols Toeplitz matrix T for which T*x is the convolution of x
+// with kernel, along with the result of convolving every row of the matrix, which is m*Tᵀ.
+// Returns an error if the kernel is empty or longer than the rows.
+func (m Matrix) Conv1DAsMatrix(kernel []float64) (Matrix, Matrix, error) {
+    if len(kernel) == 0 || len(kernel) > m.Cols {
+        return Matrix{}, Matrix{}, fmt.Errorf("%w: kernel length %d must be between 1 and the row length %d",
+            ErrDimensionMismatch, len(kernel), m.Cols)
+    }
+
+    column := make([]float64, m.Cols+len(kernel)-1)
+    copy(column, kernel)
+    row := make([]float64, m.Cols)
+    row[0] = kernel[0]
+
+    operator, err := NewToeplitzMatrix(column, row)
+    if err != nil {
+        panic(err)
+    }
+
+    result, err := m.Multiply(operator.T())
+    if err != nil {
+        panic(err)
+    }
+
+    return operator, result, nil
+}
@@ -0,0 +1,68 @@
+package matrix
+
+import (
+    "errors"
+    "reflect"
+    "testing"
+)
+
+func TestNewToeplitzMatrix(t *testing.T) {
+    result, err := NewToeplitzMatrix([]float64{1, 2, 3}, []float64{1, 4, 5, 6})
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    expected := [][]float64{
+        {1, 4, 5, 6},
+        {2, 1, 4, 5},
+        {3, 2, 1, 4},
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    _, err = NewToeplitzMatrix([]float64{1, 2}, []float64{3, 4})
+    if !errors.Is(err, ErrInvalidValue) {
+        t.Fatalf("expected ErrInvalidValue for mismatched first elements, got %v", err)
+    }
+}
+
+// TestConv1DAsMatrix tests the Toeplitz convolution against a direct convolution of each row.
+func TestConv1DAsMatrix(t *testing.T) {
+    a := Matrix{
+        Rows: 2,
+        Cols: 4,
+        Data: [][]float64{
+            {1, 2, 3, 4},
+            {0, -1, 5, 2},
+        },
+    }
+    kernel := []float64{1, 0, -2}
+
+    operator, result, err := a.Conv1DAsMatrix(kernel)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+
+    if operator.Rows != 6 || operator.Cols != 4 {
+        t.Fatalf("expected operator dimensions (6, 4), got (%d, %d)", operator.Rows, operator.Cols)
+    }
+
+    expected := make([][]float64, a.Rows)
+    for r, row := range a.Data {
+        expected[r] = make([]float64, len(row)+len(kernel)-1)
+        for i := range row {
+            for k := range kernel {
+                expected[r][i+k] += row[i] * kernel[k]
+            }
+        }
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    _, _, err = a.Conv1DAsMatrix([]float64{1, 2, 3, 4, 5})
+    if err == nil {
+        t.Fatal("expected error for kernel longer than the rows, but got none")
+    }
+}
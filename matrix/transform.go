@@ -0,0 +1,92 @@
+package matrix
+
+import (
+    "fmt"
+    "math"
+)
+
+// minors4x4 returns the twelve 2×2 determinants used by the closed-form 4×4 formulas.
+// s holds the minors of the top two rows and c those of the bottom two rows, paired so that
+// the determinant is the sum of s[k]*c[5-k] with alternating signs.
+func minors4x4(a [][]float64) (s, c [6]float64) {
+    s[0] = a[0][0]*a[1][1] - a[1][0]*a[0][1]
+    s[1] = a[0][0]*a[1][2] - a[1][0]*a[0][2]
+    s[2] = a[0][0]*a[1][3] - a[1][0]*a[0][3]
+    s[3] = a[0][1]*a[1][2] - a[1][1]*a[0][2]
+    s[4] = a[0][1]*a[1][3] - a[1][1]*a[0][3]
+    s[5] = a[0][2]*a[1][3] - a[1][2]*a[0][3]
+
+    c[5] = a[2][2]*a[3][3] - a[3][2]*a[2][3]
+    c[4] = a[2][1]*a[3][3] - a[3][1]*a[2][3]
+    c[3] = a[2][1]*a[3][2] - a[3][1]*a[2][2]
+    c[2] = a[2][0]*a[3][3] - a[3][0]*a[2][3]
+    c[1] = a[2][0]*a[3][2] - a[3][0]*a[2][2]
+    c[0] = a[2][0]*a[3][1] - a[3][0]*a[2][1]
+
+    return s, c
+}
+
+// not4x4 reports that op was given a matrix that is not 4×4.
+func not4x4(op string, m Matrix) error {
+    return fmt.Errorf("%w: %s requires a 4×4 matrix, got %s", ErrInvalidDimensions, op, shape(m))
+}
+
+// Determinant4x4 returns the determinant of a 4×4 matrix using a fully unrolled closed-form expansion.
+// This is a fast path for homogeneous transforms and avoids the factorization done by Determinant.
+// Returns an error if the matrix is not 4×4.
+func (m Matrix) Determinant4x4() (float64, error) {
+    if m.Rows != 4 || m.Cols != 4 {
+        return 0, not4x4("Determinant4x4", m)
+    }
+
+    s, c := minors4x4(m.Data)
+    return s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0], nil
+}
+
+// Inverse4x4 returns the inverse of a 4×4 matrix using the closed-form adjugate divided by the determinant.
+// This is a fast path for homogeneous transforms and avoids the factorization done by Inverse.
+// The matrix is treated as singular when |det| is below 1e-12 times the fourth power of its Frobenius norm.
+// Returns an error if the matrix is not 4×4 or is singular.
+func (m Matrix) Inverse4x4() (Matrix, error) {
+    if m.Rows != 4 || m.Cols != 4 {
+        return Matrix{}, not4x4("Inverse4x4", m)
+    }
+
+    a := m.Data
+    s, c := minors4x4(a)
+    det := s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+    // The determinant scales with the fourth power of the entries, so compare it relative to the norm
+    if norm := m.FrobeniusNorm(); math.Abs(det) < pivotTolerance*norm*norm*norm*norm {
+        return Matrix{}, ErrSingular
+    }
+    inv := 1 / det
+
+    inverse, err := NewZeroMatrix(4, 4)
+
+    if err != nil {
+        panic(err)
+    }
+
+    b := inverse.Data
+    b[0][0] = (a[1][1]*c[5] - a[1][2]*c[4] + a[1][3]*c[3]) * inv
+    b[0][1] = (-a[0][1]*c[5] + a[0][2]*c[4] - a[0][3]*c[3]) * inv
+    b[0][2] = (a[3][1]*s[5] - a[3][2]*s[4] + a[3][3]*s[3]) * inv
+    b[0][3] = (-a[2][1]*s[5] + a[2][2]*s[4] - a[2][3]*s[3]) * inv
+
+    b[1][0] = (-a[1][0]*c[5] + a[1][2]*c[2] - a[1][3]*c[1]) * inv
+    b[1][1] = (a[0][0]*c[5] - a[0][2]*c[2] + a[0][3]*c[1]) * inv
+    b[1][2] = (-a[3][0]*s[5] + a[3][2]*s[2] - a[3][3]*s[1]) * inv
+    b[1][3] = (a[2][0]*s[5] - a[2][2]*s[2] + a[2][3]*s[1]) * inv
+
+    b[2][0] = (a[1][0]*c[4] - a[1][1]*c[2] + a[1][3]*c[0]) * inv
+    b[2][1] = (-a[0][0]*c[4] + a[0][1]*c[2] - a[0][3]*c[0]) * inv
+    b[2][2] = (a[3][0]*s[4] - a[3][1]*s[2] + a[3][3]*s[0]) * inv
+    b[2][3] = (-a[2][0]*s[4] + a[2][1]*s[2] - a[2][3]*s[0]) * inv
+
+    b[3][0] = (-a[1][0]*c[3] + a[1][1]*c[1] - a[1][2]*c[0]) * inv
+    b[3][1] = (a[0][0]*c[3] - a[0][1]*c[1] + a[0][2]*c[0]) * inv
+    b[3][2] = (-a[3][0]*s[3] + a[3][1]*s[1] - a[3][2]*s[0]) * inv
+    b[3][3] = (a[2][0]*s[3] - a[2][1]*s[1] + a[2][2]*s[0]) * inv
+
+    return inverse, nil
+}
@@ -0,0 +1,104 @@
+package matrix
+
+import (
+    "errors"
+    "math"
+    "testing"
+)
+
+// transformInputs returns 4x4 matrices covering a rigid transform, a projection, and a dense general matrix.
+func transformInputs() []Matrix {
+    c, s := math.Cos(0.3), math.Sin(0.3)
+    return []Matrix{
+        {
+            Rows: 4,
+            Cols: 4,
+            Data: [][]float64{
+                {c, -s, 0, 2},
+                {s, c, 0, -1},
+                {0, 0, 1, 5},
+                {0, 0, 0, 1},
+            },
+        },
+        {
+            Rows: 4,
+            Cols: 4,
+            Data: [][]float64{
+                {1.5, 0, 0, 0},
+                {0, 2, 0, 0},
+                {0, 0, -1.2, -2.2},
+                {0, 0, -1, 0},
+            },
+        },
+        {
+            Rows: 4,
+            Cols: 4,
+            Data: [][]float64{
+                {2, -1, 3, 0.5},
+                {4, 1, -2, 7},
+                {0, 3, 1, -1},
+                {-5, 2, 2, 3},
+            },
+        },
+    }
+}
+
+// TestDeterminant4x4 tests that the closed-form determinant matches the general one.
+func TestDeterminant4x4(t *testing.T) {
+    for _, m := range transformInputs() {
+        got, err := m.Determinant4x4()
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        expected, err := m.Determinant()
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        if math.Abs(got-expected) > 1e-9 {
+            t.Fatalf("expected determinant %f, got %f", expected, got)
+        }
+    }
+
+    _, err := Matrix{Rows: 3, Cols: 3, Data: [][]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}}.Determinant4x4()
+    if !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions, got %v", err)
+    }
+}
+
+// TestInverse4x4 tests that the closed-form inverse matches the general one, including for a small-scale matrix.
+func TestInverse4x4(t *testing.T) {
+    // A uniform scale by 1e-4 has determinant 1e-16 but is perfectly conditioned
+    small := NewDiagonalMatrix([]float64{1e-4, 1e-4, 1e-4, 1e-4})
+
+    for _, m := range append(transformInputs(), small) {
+        got, err := m.Inverse4x4()
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        expected, err := m.Inverse()
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+        assertClose(t, expected.Data, got)
+    }
+
+    singular := Matrix{
+        Rows: 4,
+        Cols: 4,
+        Data: [][]float64{
+            {1, 2, 3, 4},
+            {2, 4, 6, 8},
+            {0, 1, 0, 1},
+            {1, 0, 1, 0},
+        },
+    }
+    _, err := singular.Inverse4x4()
+    if !errors.Is(err, ErrSingular) {
+        t.Fatalf("expected ErrSingular, got %v", err)
+    }
+
+    _, err = Matrix{Rows: 4, Cols: 3, Data: [][]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {0, 0, 0}}}.Inverse4x4()
+    if !errors.Is(err, ErrInvalidDimensions) {
+        t.Fatalf("expected ErrInvalidDimensions, got %v", err)
+    }
+}
@@ -0,0 +1,168 @@
+package matrix
+
+import (
+    "math"
+)
+
+// IsTriangular reports whether a square matrix is upper triangular, lower triangular, or both.
+// Entries must be exactly zero to count; a diagonal matrix is both upper and lower triangular.
+// Non-square matrices are neither.
+func (m Matrix) IsTriangular() (upper bool, lower bool) {
+    if m.Rows != m.Cols {
+        return false, false
+    }
+
+    upper, lower = true, true
+    for i := range m.Data {
+        for j := range m.Data[i] {
+            if m.Data[i][j] == 0 {
+                continue
+            }
+            if i > j {
+                upper = false
+            }
+            if i < j {
+                lower = false
+            }
+        }
+    }
+
+    return upper, lower
+}
+
+// IsUpperTriangular reports whether every entry below the main diagonal is within tol of zero.
+// Unlike IsTriangular, small rounding errors such as those left by a decomposition are accepted.
+// Non-square matrices are never upper triangular.
+func (m Matrix) IsUpperTriangular(tol float64) bool {
+    if m.Rows != m.Cols {
+        return false
+    }
+    for i := range m.Data {
+        for j := 0; j < i; j++ {
+            if math.Abs(m.Data[i][j]) > tol {
+                return false
+            }
+        }
+    }
+    return true
+}
+
+// IsLowerTriangular reports whether every entry above the main diagonal is within tol of zero.
+// Unlike IsTriangular, small rounding errors such as those left by a decomposition are accepted.
+// Non-square matrices are never lower triangular.
+func (m Matrix) IsLowerTriangular(tol float64) bool {
+    if m.Rows != m.Cols {
+        return false
+    }
+    for i := range m.Data {
+        for j := i + 1; j < m.Cols; j++ {
+            if math.Abs(m.Data[i][j]) > tol {
+                return false
+            }
+        }
+    }
+    return true
+}
+
+// triangularDeterminant returns the product of the diagonal of a triangular matrix.
+func (m Matrix) triangularDeterminant() float64 {
+    det := 1.0
+    for i := range m.Data {
+        det *= m.Data[i][i]
+    }
+    return det
+}
+
+// DiagonalProductLog returns the product of the diagonal entries of a square matrix as a sign and the
+// logarithm of its magnitude, so that the product is sign * exp(logProd). Summing logarithms avoids the
+// overflow and underflow a direct product suffers for large matrices, which makes it suitable for the
+// determinant of a triangular matrix. If any diagonal entry is zero, sign is 0 and logProd is -Inf.
+// Returns an error if the matrix is not square.
+func (m Matrix) DiagonalProductLog() (sign float64, logProd float64, err error) {
+    if m.Rows != m.Cols {
+        return 0, 0, notSquare("DiagonalProductLog", m)
+    }
+
+    sign = 1
+    for i := range m.Data {
+        d := m.Data[i][i]
+        if d == 0 {
+            return 0, math.Inf(-1), nil
+        }
+        if d < 0 {
+            sign = -sign
+        }
+        logProd += math.Log(math.Abs(d))
+    }
+
+    return sign, logProd, nil
+}
+
+// triangularInverse inverts a triangular matrix by substitution, one column at a time.
+// The inverse of an upper (lower) triangular matrix is itself upper (lower) triangular,
+// so only the entries on that side of the diagonal are computed.
+func (m Matrix) triangularInverse(upper bool) (Matrix, error) {
+    n := m.Rows
+    for i := 0; i < n; i++ {
+        if math.Abs(m.Data[i][i]) < pivotTolerance {
+            return Matrix{}, ErrSingular
+        }
+    }
+
+    inverse, err := NewZeroMatrix(n, n)
+
+    if err != nil {
+        panic(err)
+    }
+
+    for j := 0; j < n; j++ {
+        inverse.Data[j][j] = 1 / m.Data[j][j]
+        if upper {
+            // Back substitution for rows above the diagonal
+            for i := j - 1; i >= 0; i-- {
+                sum := 0.0
+                for k := i + 1; k <= j; k++ {
+                    sum += m.Data[i][k] * inverse.Data[k][j]
+                }
+                inverse.Data[i][j] = -sum / m.Data[i][i]
+            }
+        } else {
+            // Forward substitution for rows below the diagonal
+            for i := j + 1; i < n; i++ {
+                sum := 0.0
+                for k := j; k < i; k++ {
+                    sum += m.Data[i][k] * inverse.Data[k][j]
+                }
+                inverse.Data[i][j] = -sum / m.Data[i][i]
+            }
+        }
+    }
+
+    return inverse, nil
+}
+
+// Triu returns a copy of the matrix with every entry below the k-th diagonal set to zero.
+// k = 0 is the main diagonal, positive k is above it and negative k below it, as in NumPy.
+// Works for non-square matrices.
+func (m Matrix) Triu(k int) Matrix {
+    result := m.clone()
+    for i := range result.Data {
+        for j := 0; j < m.Cols && j < i+k; j++ {
+            result.Data[i][j] = 0
+        }
+    }
+    return result
+}
+
+// Tril returns a copy of the matrix with every entry above the k-th diagonal set to zero.
+// k = 0 is the main diagonal, positive k is above it and negative k below it, as in NumPy.
+// Works for non-square matrices.
+func (m Matrix) Tril(k int) Matrix {
+    result := m.clone()
+    for i := range result.Data {
+        for j := maxInt(i+k+1, 0); j < m.Cols; j++ {
+            result.Data[i][j] = 0
+        }
+    }
+    return result
+}
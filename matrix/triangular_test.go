@@ -0,0 +1,274 @@
+package matrix
+
+import (
+    "math"
+    "reflect"
+    "testing"
+)
+
+func TestIsTriangular(t *testing.T) {
+    upperMatrix := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {0, 4, 5},
+            {0, 0, 6},
+        },
+    }
+
+    upper, lower := upperMatrix.IsTriangular()
+    if !upper || lower {
+        t.Fatalf("expected upper triangular only, got upper=%v lower=%v", upper, lower)
+    }
+
+    upper, lower = upperMatrix.T().IsTriangular()
+    if upper || !lower {
+        t.Fatalf("expected lower triangular only, got upper=%v lower=%v", upper, lower)
+    }
+
+    identity, err := NewIdentityMatrix(3)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    upper, lower = identity.IsTriangular()
+    if !upper || !lower {
+        t.Fatalf("expected diagonal matrix to be both, got upper=%v lower=%v", upper, lower)
+    }
+
+    dense := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+    upper, lower = dense.IsTriangular()
+    if upper || lower {
+        t.Fatalf("expected dense matrix to be neither, got upper=%v lower=%v", upper, lower)
+    }
+}
+
+// TestIsUpperLowerTriangular tests the tolerance-based upper and lower triangular checks.
+func TestIsUpperLowerTriangular(t *testing.T) {
+    // Upper triangular apart from rounding noise below the diagonal
+    upperMatrix := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {1e-14, 4, 5},
+            {0, -1e-14, 6},
+        },
+    }
+
+    if !upperMatrix.IsUpperTriangular(1e-12) {
+        t.Fatal("expected upper triangular within tolerance")
+    }
+    if upperMatrix.IsUpperTriangular(0) {
+        t.Fatal("expected not upper triangular with zero tolerance")
+    }
+    if upperMatrix.IsLowerTriangular(1e-12) {
+        t.Fatal("expected not lower triangular")
+    }
+    if !upperMatrix.T().IsLowerTriangular(1e-12) {
+        t.Fatal("expected transpose to be lower triangular within tolerance")
+    }
+
+    dense := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {3, 4},
+        },
+    }
+    if dense.IsUpperTriangular(1e-12) || dense.IsLowerTriangular(1e-12) {
+        t.Fatal("expected dense matrix to be neither upper nor lower triangular")
+    }
+
+    wide := Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}
+    if wide.IsUpperTriangular(1e-12) || wide.IsLowerTriangular(1e-12) {
+        t.Fatal("expected non-square matrix to be neither upper nor lower triangular")
+    }
+}
+
+// TestTriangularDeterminant tests that a triangular determinant matches the diagonal product.
+func TestTriangularDeterminant(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, 7, -1},
+            {0, 3, 5},
+            {0, 0, -4},
+        },
+    }
+
+    det, err := a.Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if det != -24 {
+        t.Fatalf("expected determinant -24, got %f", det)
+    }
+
+    det, err = a.T().Determinant()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if det != -24 {
+        t.Fatalf("expected determinant -24, got %f", det)
+    }
+}
+
+// TestTriangularInverse tests that upper and lower triangular inverses give the identity.
+func TestTriangularInverse(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {2, 7, -1},
+            {0, 3, 5},
+            {0, 0, -4},
+        },
+    }
+
+    for _, m := range []Matrix{a, a.T()} {
+        inverse, err := m.Inverse()
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+
+        product, err := m.Multiply(inverse)
+        if err != nil {
+            t.Fatalf("unexpected error: %v", err)
+        }
+
+        for i := range product.Data {
+            for j := range product.Data[i] {
+                expected := 0.0
+                if i == j {
+                    expected = 1
+                }
+                if math.Abs(product.Data[i][j]-expected) > 1e-9 {
+                    t.Fatalf("expected identity, got %v", product.Data)
+                }
+            }
+        }
+    }
+
+    singular := Matrix{
+        Rows: 2,
+        Cols: 2,
+        Data: [][]float64{
+            {1, 2},
+            {0, 0},
+        },
+    }
+
+    _, err := singular.Inverse()
+    if err == nil {
+        t.Fatal("expected error for singular matrix, but got none")
+    }
+}
+
+func TestTriuTril(t *testing.T) {
+    a := Matrix{
+        Rows: 3,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+            {4, 5, 6},
+            {7, 8, 9},
+        },
+    }
+
+    tests := []struct {
+        k     int
+        upper [][]float64
+        lower [][]float64
+    }{
+        {
+            k:     0,
+            upper: [][]float64{{1, 2, 3}, {0, 5, 6}, {0, 0, 9}},
+            lower: [][]float64{{1, 0, 0}, {4, 5, 0}, {7, 8, 9}},
+        },
+        {
+            k:     1,
+            upper: [][]float64{{0, 2, 3}, {0, 0, 6}, {0, 0, 0}},
+            lower: [][]float64{{1, 2, 0}, {4, 5, 6}, {7, 8, 9}},
+        },
+        {
+            k:     -1,
+            upper: [][]float64{{1, 2, 3}, {4, 5, 6}, {0, 8, 9}},
+            lower: [][]float64{{0, 0, 0}, {4, 0, 0}, {7, 8, 0}},
+        },
+    }
+
+    for _, test := range tests {
+        if upper := a.Triu(test.k); !reflect.DeepEqual(upper.Data, test.upper) {
+            t.Fatalf("Triu(%d): expected %v, got %v", test.k, test.upper, upper.Data)
+        }
+        if lower := a.Tril(test.k); !reflect.DeepEqual(lower.Data, test.lower) {
+            t.Fatalf("Tril(%d): expected %v, got %v", test.k, test.lower, lower.Data)
+        }
+    }
+
+    if a.Data[2][0] != 7 || a.Data[0][2] != 3 {
+        t.Fatal("expected the original matrix to be unchanged")
+    }
+}
+
+func TestDiagonalProductLog(t *testing.T) {
+    a := Matrix{
+        Rows: 4,
+        Cols: 4,
+        Data: [][]float64{
+            {2, 1, 5, 3},
+            {0, -3, 4, 1},
+            {0, 0, 0.5, 7},
+            {0, 0, 0, -1.5},
+        },
+    }
+
+    sign, logProd, err := a.DiagonalProductLog()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    expected := a.triangularDeterminant()
+    if got := sign * math.Exp(logProd); math.Abs(got-expected) > 1e-12 {
+        t.Fatalf("expected product %f, got %f", expected, got)
+    }
+
+    // 400 diagonal entries of 1e3 overflow a direct product
+    large, err := NewIdentityMatrix(400)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    for i := range large.Data {
+        large.Data[i][i] = 1e3
+    }
+    large.Data[0][0] = -1e3
+    sign, logProd, err = large.DiagonalProductLog()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if sign != -1 || math.Abs(logProd-1200*math.Ln10) > 1e-9 {
+        t.Fatalf("expected sign -1 and log %f, got %f and %f", 1200*math.Ln10, sign, logProd)
+    }
+
+    a.Data[2][2] = 0
+    sign, logProd, err = a.DiagonalProductLog()
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if sign != 0 || !math.IsInf(logProd, -1) {
+        t.Fatalf("expected sign 0 and -Inf for a zero diagonal entry, got %f and %f", sign, logProd)
+    }
+
+    if _, _, err := (Matrix{Rows: 1, Cols: 2, Data: [][]float64{{1, 2}}}).DiagonalProductLog(); err == nil {
+        t.Fatal("expected error for non-square matrix, but got none")
+    }
+}
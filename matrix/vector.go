@@ -0,0 +1,90 @@
+package matrix
+
+import (
+    "fmt"
+)
+
+// Vector represents a mathematical vector
+type Vector []float64
+
+// Dot returns the inner product of two vectors.
+// Returns an error if the vectors have different lengths.
+func (v Vector) Dot(other Vector) (float64, error) {
+    if len(v) != len(other) {
+        return 0, fmt.Errorf("%w: vectors of length %d and %d", ErrDimensionMismatch, len(v), len(other))
+    }
+
+    result := 0.0
+    for i := range v {
+        result += v[i] * other[i]
+    }
+
+    return result, nil
+}
+
+// ToMatrixCol returns the vector as a single-column matrix.
+// The matrix does not share storage with the vector.
+func (v Vector) ToMatrixCol() Matrix {
+    data := make([][]float64, len(v))
+    for i, val := range v {
+        data[i] = []float64{val}
+    }
+    return Matrix{Rows: len(v), Cols: 1, Data: data}
+}
+
+// ToMatrixRow returns the vector as a single-row matrix.
+// The matrix does not share storage with the vector.
+func (v Vector) ToMatrixRow() Matrix {
+    row := make([]float64, len(v))
+    copy(row, v)
+    return Matrix{Rows: 1, Cols: len(v), Data: [][]float64{row}}
+}
+
+// Outer returns the outer product of two vectors.
+// Element (i, j) of the len(a) x len(b) result is a[i]*b[j].
+// Panics if either vector is empty; use OuterE to receive an error instead.
+func Outer(a, b []float64) Matrix {
+    result, err := OuterE(a, b)
+
+    if err != nil {
+        panic(err)
+    }
+
+    return result
+}
+
+// OuterE returns the outer product of two vectors.
+// Returns an error if either vector is empty.
+func OuterE(a, b []float64) (Matrix, error) {
+    if len(a) == 0 || len(b) == 0 {
+        return Matrix{}, fmt.Errorf("%w: outer product requires non-empty vectors", ErrInvalidDimensions)
+    }
+
+    result, err := NewZeroMatrix(len(a), len(b))
+
+    if err != nil {
+        panic(err)
+    }
+
+    for i := range a {
+        for j := range b {
+            result.Data[i][j] = a[i] * b[j]
+        }
+    }
+
+    return result, nil
+}
+
+// OuterSum returns the additive analogue of the outer product.
+// Element (i, j) of the len(a) x len(b) result is a[i]+b[j].
+// Empty inputs produce a matrix with no rows or no columns.
+func OuterSum(a, b []float64) Matrix {
+    data := make([][]float64, len(a))
+    for i := range a {
+        data[i] = make([]float64, len(b))
+        for j := range b {
+            data[i][j] = a[i] + b[j]
+        }
+    }
+    return Matrix{Rows: len(a), Cols: len(b), Data: data}
+}
@@ -0,0 +1,105 @@
+package matrix
+
+import (
+    "reflect"
+    "testing"
+)
+
+func TestDot(t *testing.T) {
+    a := Vector{1, 2, 3}
+    b := Vector{4, -5, 6}
+
+    result, err := a.Dot(b)
+    if err != nil {
+        t.Fatalf("unexpected error: %v", err)
+    }
+    if result != 12 {
+        t.Fatalf("expected 12, got %f", result)
+    }
+
+    _, err = a.Dot(Vector{1, 2})
+    if err == nil {
+        t.Fatal("expected error for vectors with different lengths, but got none")
+    }
+}
+
+func TestToMatrixCol(t *testing.T) {
+    v := Vector{1, 2, 3}
+    expected := Matrix{
+        Rows: 3,
+        Cols: 1,
+        Data: [][]float64{
+            {1},
+            {2},
+            {3},
+        },
+    }
+
+    result := v.ToMatrixCol()
+
+    if !reflect.DeepEqual(result, expected) {
+        t.Fatalf("expected %v, got %v", expected, result)
+    }
+}
+
+func TestToMatrixRow(t *testing.T) {
+    v := Vector{1, 2, 3}
+    expected := Matrix{
+        Rows: 1,
+        Cols: 3,
+        Data: [][]float64{
+            {1, 2, 3},
+        },
+    }
+
+    result := v.ToMatrixRow()
+
+    if !reflect.DeepEqual(result, expected) {
+        t.Fatalf("expected %v, got %v", expected, result)
+    }
+
+    v[0] = 100
+    if result.Data[0][0] != 1 {
+        t.Fatalf("expected matrix to be independent of the vector, got %v", result.Data)
+    }
+}
+
+func TestOuter(t *testing.T) {
+    expected := [][]float64{
+        {4, 5},
+        {8, 10},
+        {-12, -15},
+    }
+
+    result := Outer([]float64{1, 2, -3}, []float64{4, 5})
+
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    _, err := OuterE([]float64{}, []float64{4, 5})
+    if err == nil {
+        t.Fatal("expected error for empty vector, but got none")
+    }
+}
+
+func TestOuterSum(t *testing.T) {
+    expected := [][]float64{
+        {11, 21, 31},
+        {12, 22, 32},
+    }
+
+    result := OuterSum([]float64{1, 2}, []float64{10, 20, 30})
+
+    if result.Rows != 2 || result.Cols != 3 {
+        t.Fatalf("expected dimensions (2, 3), got (%d, %d)", result.Rows, result.Cols)
+    }
+    if !reflect.DeepEqual(result.Data, expected) {
+        t.Fatalf("expected %v, got %v", expected, result.Data)
+    }
+
+    empty := OuterSum(nil, []float64{1})
+    if empty.Rows != 0 || empty.Cols != 1 {
+        t.Fatalf("expected dimensions (0, 1), got (%d, %d)", empty.Rows, empty.Cols)
+    }
+}